@@ -0,0 +1,181 @@
+package retoolsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+)
+
+// Access request statuses.
+const (
+	AccessRequestPending  = "pending"
+	AccessRequestApproved = "approved"
+	AccessRequestDenied   = "denied"
+)
+
+// AccessRequest is a request of a user for access to an app, folder or resource.
+type AccessRequest struct {
+	ID             string     `json:"id"`
+	Status         string     `json:"status"`
+	RequesterID    string     `json:"requester_id"`
+	RequesterEmail string     `json:"requester_email"`
+	ObjectType     ObjectType `json:"object_type"`
+	ObjectID       string     `json:"object_id"`
+	ObjectName     string     `json:"object_name,omitempty"`
+	// AccessLevel is the access level requested, or the one granted once the request is approved.
+	AccessLevel AccessLevel `json:"access_level"`
+	// Message is the justification given by the requester.
+	Message      string `json:"message,omitempty"`
+	ReviewedBy   string `json:"reviewed_by,omitempty"`
+	DenialReason string `json:"denial_reason,omitempty"`
+	CreatedAt    string `json:"created_at"`
+	ReviewedAt   string `json:"reviewed_at,omitempty"`
+}
+
+// Pending reports whether the request is waiting for a review.
+func (r *AccessRequest) Pending() bool {
+	return r.Status == AccessRequestPending
+}
+
+// ListAccessRequestsOpts is a struct that contains optional query parameters for ListAccessRequests.
+type ListAccessRequestsOpts struct {
+	// Status is AccessRequestPending, AccessRequestApproved or AccessRequestDenied.
+	Status      string
+	RequesterID string
+	// ObjectType and ObjectID filter the requests by the object access is requested to. ObjectID requires
+	// ObjectType.
+	ObjectType ObjectType
+	ObjectID   string
+}
+
+// validate ensures that the status and object type are valid.
+func (o *ListAccessRequestsOpts) validate() error {
+	if o == nil {
+		return nil
+	}
+
+	switch o.Status {
+	case "", AccessRequestPending, AccessRequestApproved, AccessRequestDenied:
+	default:
+		return fmt.Errorf("invalid access request status: %s", o.Status)
+	}
+
+	if o.ObjectType != "" {
+		if err := o.ObjectType.Validate(); err != nil {
+			return fmt.Errorf("validating object type: %w", err)
+		}
+	} else if o.ObjectID != "" {
+		return errors.New("object type is required to filter by object id")
+	}
+
+	return nil
+}
+
+// values returns the query parameters for the options.
+func (o *ListAccessRequestsOpts) values() url.Values {
+	query := make(url.Values)
+
+	if o == nil {
+		return query
+	}
+
+	if o.Status != "" {
+		query.Add("status", o.Status)
+	}
+	if o.RequesterID != "" {
+		query.Add("requester_id", o.RequesterID)
+	}
+	if o.ObjectType != "" {
+		query.Add("object_type", o.ObjectType.String())
+	}
+	if o.ObjectID != "" {
+		query.Add("object_id", o.ObjectID)
+	}
+
+	return query
+}
+
+// ListAccessRequests returns the access requests matching the filters, most recent first.
+// The API token must have the "Permissions > Read" scope.
+func (c *Client) ListAccessRequests(ctx context.Context, opts *ListAccessRequestsOpts) ([]AccessRequest, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/access_requests", c.BaseURL)
+	return doPaginatedRequest[AccessRequest](ctx, c, "GET", baseURL, nil, opts.values())
+}
+
+// AllAccessRequests returns an iterator over the access requests matching the filters, fetching pages lazily as the
+// iteration progresses. The API token must have the "Permissions > Read" scope.
+func (c *Client) AllAccessRequests(ctx context.Context, opts *ListAccessRequestsOpts) iter.Seq2[AccessRequest, error] {
+	return paginateItems(c.AccessRequestPages(ctx, opts, ""))
+}
+
+// AccessRequestPages returns an iterator over the pages of access requests matching the filters, starting at the page
+// identified by next (or the first page when empty). The API token must have the "Permissions > Read" scope.
+func (c *Client) AccessRequestPages(ctx context.Context, opts *ListAccessRequestsOpts, next string) iter.Seq2[*Page[AccessRequest], error] {
+	if err := opts.validate(); err != nil {
+		return failedPages[AccessRequest](err)
+	}
+
+	baseURL := fmt.Sprintf("%s/access_requests", c.BaseURL)
+	return paginatePages[AccessRequest](ctx, c, "GET", baseURL, nil, opts.values(), next)
+}
+
+// GetAccessRequest returns the access request with the given ID. The API token must have the "Permissions > Read" scope.
+func (c *Client) GetAccessRequest(ctx context.Context, id string) (*AccessRequest, error) {
+	baseURL := fmt.Sprintf("%s/access_requests/%s", c.BaseURL, id)
+	return doSingleRequest[AccessRequest](ctx, c, "GET", baseURL, nil)
+}
+
+// ApproveAccessRequest approves the access request, granting the requester the access level, which may differ from
+// the one requested, and returns the reviewed request. The API token must have the "Permissions > Write" scope.
+func (c *Client) ApproveAccessRequest(ctx context.Context, id string, accessLevel AccessLevel) (*AccessRequest, error) {
+	if err := accessLevel.Validate(); err != nil {
+		return nil, fmt.Errorf("validating access level: %w", err)
+	}
+
+	if accessLevel == NoneAccess {
+		return nil, errors.New("cannot approve an access request with access level none")
+	}
+
+	requestBody := struct {
+		AccessLevel AccessLevel `json:"access_level"`
+	}{
+		AccessLevel: accessLevel,
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	baseURL := fmt.Sprintf("%s/access_requests/%s/approve", c.BaseURL, id)
+	return doSingleRequest[AccessRequest](ctx, c, "POST", baseURL, requestBodyJSON)
+}
+
+// DenyAccessRequest denies the access request and returns the reviewed request. The reason is shown to the requester.
+// The API token must have the "Permissions > Write" scope.
+func (c *Client) DenyAccessRequest(ctx context.Context, id, reason string) (*AccessRequest, error) {
+	if reason == "" {
+		return nil, errors.New("deny reason cannot be empty")
+	}
+
+	requestBody := struct {
+		Reason string `json:"reason"`
+	}{
+		Reason: reason,
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	baseURL := fmt.Sprintf("%s/access_requests/%s/deny", c.BaseURL, id)
+	return doSingleRequest[AccessRequest](ctx, c, "POST", baseURL, requestBodyJSON)
+}
@@ -0,0 +1,113 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAccessRequests_Filters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/v2/access_requests", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "pending", query.Get("status"))
+		assert.Equal(t, "user_1", query.Get("requester_id"))
+		assert.Equal(t, "app", query.Get("object_type"))
+		assert.Equal(t, "app_1", query.Get("object_id"))
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "req_1", "status": "pending", "requester_id": "user_1", "object_type": "app", "object_id": "app_1", "access_level": "use", "message": "Need it for on-call"}], "total_count": 1, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	requests, err := client.ListAccessRequests(context.Background(), &retool.ListAccessRequestsOpts{
+		Status:      retool.AccessRequestPending,
+		RequesterID: "user_1",
+		ObjectType:  retool.AppObject,
+		ObjectID:    "app_1",
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, requests, 1) {
+		assert.True(t, requests[0].Pending())
+		assert.Equal(t, retool.AccessLevel(retool.UseAccess), requests[0].AccessLevel)
+		assert.Equal(t, retool.ObjectType(retool.AppObject), requests[0].ObjectType)
+	}
+}
+
+func TestListAccessRequests_InvalidFilters(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://retool.example.com")
+	assert.NoError(t, err)
+
+	_, err = client.ListAccessRequests(context.Background(), &retool.ListAccessRequestsOpts{Status: "expired"})
+	assert.EqualError(t, err, "invalid access request status: expired")
+
+	_, err = client.ListAccessRequests(context.Background(), &retool.ListAccessRequestsOpts{ObjectType: "workflow"})
+	assert.EqualError(t, err, "validating object type: invalid object type: workflow")
+
+	_, err = client.ListAccessRequests(context.Background(), &retool.ListAccessRequestsOpts{ObjectID: "app_1"})
+	assert.EqualError(t, err, "object type is required to filter by object id")
+
+	for _, err := range client.AllAccessRequests(context.Background(), &retool.ListAccessRequestsOpts{Status: "expired"}) {
+		assert.EqualError(t, err, "invalid access request status: expired")
+	}
+}
+
+func TestApproveAccessRequest_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/access_requests/req_1/approve", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"access_level": "edit"}`, string(body))
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "req_1", "status": "approved", "access_level": "edit", "reviewed_by": "admin@example.com"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	request, err := client.ApproveAccessRequest(context.Background(), "req_1", retool.EditAccess)
+	assert.NoError(t, err)
+	assert.Equal(t, retool.AccessRequestApproved, request.Status)
+	assert.False(t, request.Pending())
+}
+
+func TestApproveAccessRequest_InvalidAccessLevel(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://retool.example.com")
+	assert.NoError(t, err)
+
+	_, err = client.ApproveAccessRequest(context.Background(), "req_1", "admin")
+	assert.EqualError(t, err, "validating access level: invalid access level: admin")
+
+	_, err = client.ApproveAccessRequest(context.Background(), "req_1", retool.NoneAccess)
+	assert.EqualError(t, err, "cannot approve an access request with access level none")
+}
+
+func TestDenyAccessRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/access_requests/req_1/deny", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"reason": "Request access through your manager"}`, string(body))
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "req_1", "status": "denied", "denial_reason": "Request access through your manager"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	request, err := client.DenyAccessRequest(context.Background(), "req_1", "Request access through your manager")
+	assert.NoError(t, err)
+	assert.Equal(t, retool.AccessRequestDenied, request.Status)
+
+	_, err = client.DenyAccessRequest(context.Background(), "req_1", "")
+	assert.EqualError(t, err, "deny reason cannot be empty")
+}
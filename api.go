@@ -0,0 +1,271 @@
+package retoolsdk
+
+import (
+	"context"
+	"io"
+	"iter"
+	"time"
+)
+
+// UsersAPI is the set of user and user attribute operations of the Retool API.
+type UsersAPI interface {
+	GetUser(ctx context.Context, id string) (*User, error)
+	ListUsers(ctx context.Context, opts *ListUserOpts) ([]User, error)
+	AllUsers(ctx context.Context, opts *ListUserOpts) iter.Seq2[User, error]
+	UserPages(ctx context.Context, opts *ListUserOpts, next string) iter.Seq2[*Page[User], error]
+	CreateUser(ctx context.Context, email, firstName, lastName string, opts *CreateUserOpts) (*User, error)
+	UpdateUser(ctx context.Context, id string, operations []UpdateOperations) (*User, error)
+	DeleteUser(ctx context.Context, id string) error
+	UpdateUserAttributes(ctx context.Context, id string, attributes []UserAttribute) (map[string]interface{}, error)
+	DeleteUserAttribute(ctx context.Context, id, attribute string) (interface{}, error)
+	GetOrganizationAttributes(ctx context.Context) ([]OrganizationAttribute, error)
+	AllOrganizationAttributes(ctx context.Context) iter.Seq2[OrganizationAttribute, error]
+	OrganizationAttributePages(ctx context.Context, next string) iter.Seq2[*Page[OrganizationAttribute], error]
+}
+
+// GroupsAPI is the set of group operations of the Retool API.
+type GroupsAPI interface {
+	GetGroup(ctx context.Context, id string) (*Group, error)
+	ListGroups(ctx context.Context) ([]Group, error)
+	AllGroups(ctx context.Context) iter.Seq2[Group, error]
+	GroupPages(ctx context.Context, next string) iter.Seq2[*Page[Group], error]
+	CreateGroup(ctx context.Context, group *Group) (*Group, error)
+	UpdateGroup(ctx context.Context, id string, operations []UpdateOperations) (*Group, error)
+	DeleteGroup(ctx context.Context, id string) error
+	AddUsersToGroup(ctx context.Context, groupID string, members []Member) (*Group, error)
+	RemoveUserFromGroup(ctx context.Context, groupID, userID string) (*Group, error)
+}
+
+// FoldersAPI is the set of folder operations of the Retool API.
+type FoldersAPI interface {
+	GetFolder(ctx context.Context, id string) (*Folder, error)
+	ListFolders(ctx context.Context) ([]Folder, error)
+	AllFolders(ctx context.Context) iter.Seq2[Folder, error]
+	FolderPages(ctx context.Context, next string) iter.Seq2[*Page[Folder], error]
+	CreateFolder(ctx context.Context, name, parentFolderID string, folderType FolderType) (*Folder, error)
+	UpdateFolder(ctx context.Context, id string, operations []UpdateOperations) (*Folder, error)
+	DeleteFolder(ctx context.Context, id string) error
+}
+
+// PermissionsAPI is the set of permission operations of the Retool API.
+type PermissionsAPI interface {
+	GetFolderOrAppAccessList(ctx context.Context, objectID, objectType ObjectType) (*GroupedData, error)
+	ListGroupObjectPermissions(ctx context.Context, subject string, objectType ObjectType, id any) ([]Subject, error)
+	GrantPermission(ctx context.Context, subject string, subjectID any, objectType ObjectType, objectID string, accessLevel AccessLevel) ([]Subject, error)
+	RevokePermission(ctx context.Context, subject string, subjectID any, objectType ObjectType, objectID string) ([]Subject, error)
+}
+
+// SpacesAPI is the set of space operations of the Retool API.
+type SpacesAPI interface {
+	GetSpace(ctx context.Context, id string) (*Space, error)
+	ListSpaces(ctx context.Context) ([]Space, error)
+	AllSpaces(ctx context.Context) iter.Seq2[Space, error]
+	SpacePages(ctx context.Context, next string) iter.Seq2[*Page[Space], error]
+	CreateSpace(ctx context.Context, name, domain string, options *CreateSpaceOptions) (*Space, error)
+	UpdateSpace(ctx context.Context, id, name, domain string) (*Space, error)
+	DeleteSpace(ctx context.Context, id string) error
+}
+
+// ConfigVarsAPI is the set of configuration variable operations of the Retool API.
+type ConfigVarsAPI interface {
+	GetConfigurationVariable(ctx context.Context, id string) (*ConfigurationVariable, error)
+	ListConfigurationVariables(ctx context.Context) ([]ConfigurationVariable, error)
+	AllConfigurationVariables(ctx context.Context) iter.Seq2[ConfigurationVariable, error]
+	ConfigurationVariablePages(ctx context.Context, next string) iter.Seq2[*Page[ConfigurationVariable], error]
+	CreateConfigurationVariable(ctx context.Context, name, description string, secret bool, values []Value) (*ConfigurationVariable, error)
+	UpdateConfigurationVariable(ctx context.Context, id, name, description string, secret bool, values []Value) (*ConfigurationVariable, error)
+	DeleteConfigurationVariable(ctx context.Context, id string) error
+}
+
+// AppsAPI is the set of app operations of the Retool API.
+type AppsAPI interface {
+	GetApp(ctx context.Context, id string) (*App, error)
+	ListApps(ctx context.Context, opts *ListAppsOpts) ([]App, error)
+	AllApps(ctx context.Context, opts *ListAppsOpts) iter.Seq2[App, error]
+	AppPages(ctx context.Context, opts *ListAppsOpts, next string) iter.Seq2[*Page[App], error]
+	MoveAppToFolder(ctx context.Context, id, folderID string) (*App, error)
+	DeleteApp(ctx context.Context, id string) error
+}
+
+// WorkflowsAPI is the set of workflow and workflow run operations of the Retool API.
+type WorkflowsAPI interface {
+	GetWorkflow(ctx context.Context, id string) (*Workflow, error)
+	ListWorkflows(ctx context.Context) ([]Workflow, error)
+	AllWorkflows(ctx context.Context) iter.Seq2[Workflow, error]
+	WorkflowPages(ctx context.Context, next string) iter.Seq2[*Page[Workflow], error]
+	TriggerWorkflow(ctx context.Context, id string, payload any, opts *TriggerWorkflowOpts) (*WorkflowRun, error)
+	ListWorkflowRuns(ctx context.Context, workflowID string, opts *ListWorkflowRunsOpts) ([]WorkflowRun, error)
+	AllWorkflowRuns(ctx context.Context, workflowID string, opts *ListWorkflowRunsOpts) iter.Seq2[WorkflowRun, error]
+	WorkflowRunPages(ctx context.Context, workflowID string, opts *ListWorkflowRunsOpts, next string) iter.Seq2[*Page[WorkflowRun], error]
+	GetWorkflowRun(ctx context.Context, workflowID, runID string) (*WorkflowRun, error)
+	CancelWorkflowRun(ctx context.Context, workflowID, runID string) (*WorkflowRun, error)
+}
+
+// ResourcesAPI is the set of resource and resource configuration operations of the Retool API.
+type ResourcesAPI interface {
+	GetResource(ctx context.Context, id string) (*Resource, error)
+	ListResources(ctx context.Context, opts *ListResourcesOpts) ([]Resource, error)
+	AllResources(ctx context.Context, opts *ListResourcesOpts) iter.Seq2[Resource, error]
+	ResourcePages(ctx context.Context, opts *ListResourcesOpts, next string) iter.Seq2[*Page[Resource], error]
+	CreateResource(ctx context.Context, displayName, folderID string, options ResourceOptions) (*Resource, error)
+	UpdateResource(ctx context.Context, id, displayName, folderID string, options ResourceOptions) (*Resource, error)
+	DeleteResource(ctx context.Context, id string) error
+	ListResourceConfigurations(ctx context.Context, resourceID string) ([]ResourceConfiguration, error)
+	AllResourceConfigurations(ctx context.Context, resourceID string) iter.Seq2[ResourceConfiguration, error]
+	ResourceConfigurationPages(ctx context.Context, resourceID, next string) iter.Seq2[*Page[ResourceConfiguration], error]
+	GetResourceConfiguration(ctx context.Context, resourceID, configurationID string) (*ResourceConfiguration, error)
+	CreateResourceConfiguration(ctx context.Context, resourceID, environmentID string, options ResourceOptions) (*ResourceConfiguration, error)
+	UpdateResourceConfiguration(ctx context.Context, resourceID, configurationID, environmentID string, options ResourceOptions) (*ResourceConfiguration, error)
+	DeleteResourceConfiguration(ctx context.Context, resourceID, configurationID string) error
+}
+
+// EnvironmentsAPI is the set of environment operations of the Retool API.
+type EnvironmentsAPI interface {
+	GetEnvironment(ctx context.Context, id string) (*Environment, error)
+	ListEnvironments(ctx context.Context) ([]Environment, error)
+	AllEnvironments(ctx context.Context) iter.Seq2[Environment, error]
+	EnvironmentPages(ctx context.Context, next string) iter.Seq2[*Page[Environment], error]
+	CreateEnvironment(ctx context.Context, name, description, color string) (*Environment, error)
+	UpdateEnvironment(ctx context.Context, id string, operations []UpdateOperations) (*Environment, error)
+	DeleteEnvironment(ctx context.Context, id string) error
+	EnvironmentID(ctx context.Context, name string) (string, error)
+}
+
+// SourceControlAPI is the set of source control operations of the Retool API.
+type SourceControlAPI interface {
+	GetSourceControlConfig(ctx context.Context) (*SourceControlConfig, error)
+	UpdateSourceControlConfig(ctx context.Context, config *SourceControlConfig) (*SourceControlConfig, error)
+	GetSourceControlSettings(ctx context.Context) (*SourceControlSettings, error)
+	UpdateSourceControlSettings(ctx context.Context, settings SourceControlSettings) (*SourceControlSettings, error)
+	TestSourceControlConnection(ctx context.Context, config *SourceControlConfig) (*ConnectionTestResult, error)
+	ListBranches(ctx context.Context) ([]Branch, error)
+	AllBranches(ctx context.Context) iter.Seq2[Branch, error]
+	BranchPages(ctx context.Context, next string) iter.Seq2[*Page[Branch], error]
+	CreateBranch(ctx context.Context, name, source string) (*Branch, error)
+	DeleteBranch(ctx context.Context, name string) error
+	ListCommits(ctx context.Context, opts *ListCommitsOpts) ([]Commit, error)
+	AllCommits(ctx context.Context, opts *ListCommitsOpts) iter.Seq2[Commit, error]
+	CommitPages(ctx context.Context, opts *ListCommitsOpts, next string) iter.Seq2[*Page[Commit], error]
+	TriggerDeploy(ctx context.Context, opts *TriggerDeployOpts) (*Deploy, error)
+	ListDeploys(ctx context.Context) ([]Deploy, error)
+	AllDeploys(ctx context.Context) iter.Seq2[Deploy, error]
+	DeployPages(ctx context.Context, next string) iter.Seq2[*Page[Deploy], error]
+	GetDeploy(ctx context.Context, id string) (*Deploy, error)
+}
+
+// UserInvitesAPI is the set of user invite operations of the Retool API.
+type UserInvitesAPI interface {
+	CreateUserInvite(ctx context.Context, email string, opts *CreateUserInviteOpts) (*UserInvite, error)
+	GetUserInvite(ctx context.Context, id string) (*UserInvite, error)
+	ListUserInvites(ctx context.Context) ([]UserInvite, error)
+	AllUserInvites(ctx context.Context) iter.Seq2[UserInvite, error]
+	UserInvitePages(ctx context.Context, next string) iter.Seq2[*Page[UserInvite], error]
+	RevokeUserInvite(ctx context.Context, id string) error
+	ResendUserInvite(ctx context.Context, id string) (*UserInvite, error)
+}
+
+// SSOAPI is the set of single sign-on operations of the Retool API.
+type SSOAPI interface {
+	GetSSOConfig(ctx context.Context) (SSOConfig, error)
+	SetSSOConfig(ctx context.Context, config SSOConfig) (SSOConfig, error)
+	DeleteSSOConfig(ctx context.Context) error
+}
+
+// EmbedAPI is the set of Retool Embed operations.
+type EmbedAPI interface {
+	CreateEmbedURL(ctx context.Context, request *EmbedURLRequest) (*EmbedURL, error)
+}
+
+// UsageAPI is the set of usage analytics operations of the Retool API.
+type UsageAPI interface {
+	GetUsage(ctx context.Context, start, end time.Time, opts *UsageOpts) (*Usage, error)
+	ListAppUsage(ctx context.Context, start, end time.Time, opts *UsageOpts) ([]AppUsage, error)
+	AllAppUsage(ctx context.Context, start, end time.Time, opts *UsageOpts) iter.Seq2[AppUsage, error]
+	AppUsagePages(ctx context.Context, start, end time.Time, opts *UsageOpts, next string) iter.Seq2[*Page[AppUsage], error]
+	ListUserUsage(ctx context.Context, start, end time.Time, opts *UsageOpts) ([]UserUsage, error)
+	AllUserUsage(ctx context.Context, start, end time.Time, opts *UsageOpts) iter.Seq2[UserUsage, error]
+	UserUsagePages(ctx context.Context, start, end time.Time, opts *UsageOpts, next string) iter.Seq2[*Page[UserUsage], error]
+	GetSeatUtilization(ctx context.Context, start, end time.Time) ([]SeatUtilization, error)
+}
+
+// AuditLogsAPI is the set of audit log operations of the Retool API.
+type AuditLogsAPI interface {
+	ListAuditLogs(ctx context.Context, opts *ListAuditLogsOpts) ([]AuditLog, error)
+	AllAuditLogs(ctx context.Context, opts *ListAuditLogsOpts) iter.Seq2[AuditLog, error]
+	AuditLogPages(ctx context.Context, opts *ListAuditLogsOpts, next string) iter.Seq2[*Page[AuditLog], error]
+	ExportAuditLogs(ctx context.Context, w io.Writer, format string, opts *ListAuditLogsOpts) (int, error)
+}
+
+// CustomComponentsAPI is the set of custom component library operations of the Retool API.
+type CustomComponentsAPI interface {
+	ListCustomComponentLibraries(ctx context.Context) ([]CustomComponentLibrary, error)
+	AllCustomComponentLibraries(ctx context.Context) iter.Seq2[CustomComponentLibrary, error]
+	CustomComponentLibraryPages(ctx context.Context, next string) iter.Seq2[*Page[CustomComponentLibrary], error]
+	CreateCustomComponentLibrary(ctx context.Context, name, label, description string) (*CustomComponentLibrary, error)
+	ListLibraryRevisions(ctx context.Context, libraryID string) ([]LibraryRevision, error)
+	AllLibraryRevisions(ctx context.Context, libraryID string) iter.Seq2[LibraryRevision, error]
+	LibraryRevisionPages(ctx context.Context, libraryID, next string) iter.Seq2[*Page[LibraryRevision], error]
+	UploadLibraryRevision(ctx context.Context, libraryID string, bundle io.Reader) (*LibraryRevision, error)
+}
+
+// ThemesAPI is the set of app theme operations of the Retool API.
+type ThemesAPI interface {
+	GetTheme(ctx context.Context, id string) (*Theme, error)
+	ListThemes(ctx context.Context) ([]Theme, error)
+	AllThemes(ctx context.Context) iter.Seq2[Theme, error]
+	ThemePages(ctx context.Context, next string) iter.Seq2[*Page[Theme], error]
+	CreateTheme(ctx context.Context, theme *Theme) (*Theme, error)
+	UpdateTheme(ctx context.Context, id string, theme *Theme) (*Theme, error)
+	DeleteTheme(ctx context.Context, id string) error
+	SetDefaultTheme(ctx context.Context, id string) (*Theme, error)
+	CopyThemeToSpace(ctx context.Context, id string, child ThemesAPI, opts *CopyThemeOpts) (*Theme, error)
+}
+
+// ObservabilityAPI is the set of observability integration operations of the Retool API.
+type ObservabilityAPI interface {
+	GetObservabilityConfig(ctx context.Context, id string) (*ObservabilityConfig, error)
+	ListObservabilityConfigs(ctx context.Context) ([]ObservabilityConfig, error)
+	AllObservabilityConfigs(ctx context.Context) iter.Seq2[ObservabilityConfig, error]
+	ObservabilityConfigPages(ctx context.Context, next string) iter.Seq2[*Page[ObservabilityConfig], error]
+	CreateObservabilityConfig(ctx context.Context, config *ObservabilityConfig) (*ObservabilityConfig, error)
+	UpdateObservabilityConfig(ctx context.Context, id string, config *ObservabilityConfig) (*ObservabilityConfig, error)
+	DeleteObservabilityConfig(ctx context.Context, id string) error
+	TestObservabilityConnection(ctx context.Context, config *ObservabilityConfig) (*ConnectionTestResult, error)
+}
+
+// AccessRequestsAPI is the set of access request operations of the Retool API.
+type AccessRequestsAPI interface {
+	ListAccessRequests(ctx context.Context, opts *ListAccessRequestsOpts) ([]AccessRequest, error)
+	AllAccessRequests(ctx context.Context, opts *ListAccessRequestsOpts) iter.Seq2[AccessRequest, error]
+	AccessRequestPages(ctx context.Context, opts *ListAccessRequestsOpts, next string) iter.Seq2[*Page[AccessRequest], error]
+	GetAccessRequest(ctx context.Context, id string) (*AccessRequest, error)
+	ApproveAccessRequest(ctx context.Context, id string, accessLevel AccessLevel) (*AccessRequest, error)
+	DenyAccessRequest(ctx context.Context, id, reason string) (*AccessRequest, error)
+}
+
+// API is the full set of Retool API operations implemented by Client. Consumers can depend on API, or on one of the
+// smaller interfaces, and substitute a fake such as retoolmock.Client in tests.
+type API interface {
+	UsersAPI
+	GroupsAPI
+	FoldersAPI
+	PermissionsAPI
+	SpacesAPI
+	ConfigVarsAPI
+	AppsAPI
+	WorkflowsAPI
+	ResourcesAPI
+	EnvironmentsAPI
+	SourceControlAPI
+	UserInvitesAPI
+	SSOAPI
+	EmbedAPI
+	UsageAPI
+	AuditLogsAPI
+	CustomComponentsAPI
+	ThemesAPI
+	ObservabilityAPI
+	AccessRequestsAPI
+}
+
+var _ API = (*Client)(nil)
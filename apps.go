@@ -0,0 +1,104 @@
+package retoolsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+)
+
+// App is a struct that contains the information about a Retool app.
+type App struct {
+	ID          string `json:"id"`
+	LegacyID    string `json:"legacy_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	FolderID    string `json:"folder_id"`
+	IsModule    bool   `json:"is_module"`
+	IsMobileApp bool   `json:"is_mobile_app"`
+	Protected   bool   `json:"protected"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// GetApp returns the app with the given ID. The API token must have the "Apps > Read" scope.
+func (c *Client) GetApp(ctx context.Context, id string) (*App, error) {
+	baseURL := fmt.Sprintf("%s/apps/%s", c.BaseURL, id)
+	return doSingleRequest[App](ctx, c, "GET", baseURL, nil)
+}
+
+// ListAppsOpts is a struct that contains optional query parameters for ListApps.
+type ListAppsOpts struct {
+	// FolderID only returns the apps in the folder with the given ID.
+	FolderID string
+	// Name only returns the apps with the given name.
+	Name string
+}
+
+// values returns the query parameters for the options.
+func (o *ListAppsOpts) values() url.Values {
+	query := make(url.Values)
+
+	if o == nil {
+		return query
+	}
+
+	if o.FolderID != "" {
+		query.Add("folder_id", o.FolderID)
+	}
+	if o.Name != "" {
+		query.Add("name", o.Name)
+	}
+
+	return query
+}
+
+// ListApps returns a list of apps. The API token must have the "Apps > Read" scope.
+func (c *Client) ListApps(ctx context.Context, opts *ListAppsOpts) ([]App, error) {
+	baseURL := fmt.Sprintf("%s/apps", c.BaseURL)
+	return doPaginatedRequest[App](ctx, c, "GET", baseURL, nil, opts.values())
+}
+
+// AllApps returns an iterator over all apps, fetching pages lazily as the iteration progresses.
+// The API token must have the "Apps > Read" scope.
+func (c *Client) AllApps(ctx context.Context, opts *ListAppsOpts) iter.Seq2[App, error] {
+	return paginateItems(c.AppPages(ctx, opts, ""))
+}
+
+// AppPages returns an iterator over the pages of apps, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Apps > Read" scope.
+func (c *Client) AppPages(ctx context.Context, opts *ListAppsOpts, next string) iter.Seq2[*Page[App], error] {
+	baseURL := fmt.Sprintf("%s/apps", c.BaseURL)
+	return paginatePages[App](ctx, c, "GET", baseURL, nil, opts.values(), next)
+}
+
+// MoveAppToFolder moves the app into the folder with the given ID and returns the updated app.
+// The API token must have the "Apps > Write" scope.
+func (c *Client) MoveAppToFolder(ctx context.Context, id, folderID string) (*App, error) {
+	if folderID == "" {
+		return nil, errors.New("folder id cannot be empty")
+	}
+
+	requestBody := struct {
+		Operations []UpdateOperations `json:"operations"`
+	}{
+		Operations: []UpdateOperations{{Op: OpReplace, Path: "/folder_id", Value: folderID}},
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	baseURL := fmt.Sprintf("%s/apps/%s", c.BaseURL, id)
+	return doSingleRequest[App](ctx, c, "PATCH", baseURL, requestBodyJSON)
+}
+
+// DeleteApp deletes the app with the given ID. The API token must have the "Apps > Write" scope.
+func (c *Client) DeleteApp(ctx context.Context, id string) error {
+	baseURL := fmt.Sprintf("%s/apps/%s", c.BaseURL, id)
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
+	return err
+}
@@ -0,0 +1,129 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetApp_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/v2/apps/app_123", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "app_123", "name": "Dashboard", "folder_id": "folder_123", "is_module": false}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	app, err := client.GetApp(context.Background(), "app_123")
+	assert.NoError(t, err)
+	assert.Equal(t, &retool.App{ID: "app_123", Name: "Dashboard", FolderID: "folder_123"}, app)
+}
+
+func TestGetApp_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"success": false, "message": "App not found"}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	app, err := client.GetApp(context.Background(), "app_123")
+	assert.Nil(t, app)
+	assert.ErrorIs(t, err, retool.ErrNotFound)
+	assert.EqualError(t, err, "App not found")
+}
+
+func TestListApps_Filters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/apps", r.URL.Path)
+		assert.Equal(t, "folder_123", r.URL.Query().Get("folder_id"))
+		assert.Equal(t, "Dashboard", r.URL.Query().Get("name"))
+
+		if r.URL.Query().Get("next") == "" {
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "app_1"}], "total_count": 2, "has_more": true, "next_token": "page_2"}`)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "app_2"}], "total_count": 2, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	apps, err := client.ListApps(context.Background(), &retool.ListAppsOpts{FolderID: "folder_123", Name: "Dashboard"})
+	assert.NoError(t, err)
+	assert.Equal(t, []retool.App{{ID: "app_1"}, {ID: "app_2"}}, apps)
+}
+
+func TestListApps_NoFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.RawQuery)
+		fmt.Fprintln(w, `{"success": true, "data": [], "total_count": 0, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	apps, err := client.ListApps(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, apps)
+}
+
+func TestMoveAppToFolder_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/v2/apps/app_123", r.URL.Path)
+
+		var body struct {
+			Operations []retool.UpdateOperations `json:"operations"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []retool.UpdateOperations{{Op: "replace", Path: "/folder_id", Value: "folder_456"}}, body.Operations)
+
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "app_123", "folder_id": "folder_456"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	app, err := client.MoveAppToFolder(context.Background(), "app_123", "folder_456")
+	assert.NoError(t, err)
+	assert.Equal(t, "folder_456", app.FolderID)
+}
+
+func TestMoveAppToFolder_EmptyFolder(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://example.com")
+	assert.NoError(t, err)
+
+	app, err := client.MoveAppToFolder(context.Background(), "app_123", "")
+	assert.Nil(t, app)
+	assert.EqualError(t, err, "folder id cannot be empty")
+}
+
+func TestDeleteApp_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api/v2/apps/app_123", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.DeleteApp(context.Background(), "app_123"))
+}
@@ -0,0 +1,128 @@
+package retoolsdk_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func auditLogServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/audit_logs", r.URL.Path)
+
+		switch r.URL.Query().Get("next") {
+		case "":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "log_1", "user_email": "jane@example.com", "action_type": "QUERY_RUN", "app_name": "Orders", "metadata": {"query": "getOrders"}, "created_at": "2024-01-02T10:00:00Z"}], "total_count": 2, "has_more": true, "next_token": "page2"}`)
+		case "page2":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "log_2", "user_email": "john@example.com", "action_type": "PAGE_VIEW", "app_name": "Refunds, EU", "created_at": "2024-01-01T09:00:00Z"}], "total_count": 2, "has_more": false}`)
+		default:
+			t.Errorf("unexpected page %s", r.URL.Query().Get("next"))
+		}
+	}))
+}
+
+func TestListAuditLogs_Filters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, "2024-01-01T00:00:00Z", query.Get("start_time"))
+		assert.Equal(t, "2024-01-02T00:00:00Z", query.Get("end_time"))
+		assert.Equal(t, "user_1", query.Get("user_id"))
+		assert.Equal(t, "QUERY_RUN", query.Get("action_type"))
+		assert.Equal(t, "app_1", query.Get("app_id"))
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "log_1", "action_type": "QUERY_RUN"}], "total_count": 1, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	logs, err := client.ListAuditLogs(context.Background(), &retool.ListAuditLogsOpts{
+		Start:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:        time.Date(2024, 1, 2, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+		UserID:     "user_1",
+		ActionType: "QUERY_RUN",
+		AppID:      "app_1",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, logs, 1)
+}
+
+func TestAllAuditLogs_Streams(t *testing.T) {
+	server := auditLogServer(t)
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	var ids []string
+	for log, err := range client.AllAuditLogs(context.Background(), nil) {
+		assert.NoError(t, err)
+		ids = append(ids, log.ID)
+	}
+	assert.Equal(t, []string{"log_1", "log_2"}, ids)
+}
+
+func TestExportAuditLogs_JSONLines(t *testing.T) {
+	server := auditLogServer(t)
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	written, err := client.ExportAuditLogs(context.Background(), &buf, retool.AuditLogFormatJSONLines, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, written)
+	assert.Equal(t, `{"id":"log_1","user_id":"","user_email":"jane@example.com","action_type":"QUERY_RUN","app_name":"Orders","metadata":{"query":"getOrders"},"created_at":"2024-01-02T10:00:00Z"}
+{"id":"log_2","user_id":"","user_email":"john@example.com","action_type":"PAGE_VIEW","app_name":"Refunds, EU","created_at":"2024-01-01T09:00:00Z"}
+`, buf.String())
+}
+
+func TestExportAuditLogs_CSV(t *testing.T) {
+	server := auditLogServer(t)
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	written, err := client.ExportAuditLogs(context.Background(), &buf, retool.AuditLogFormatCSV, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, written)
+	assert.Equal(t, `id,created_at,user_id,user_email,action_type,app_id,app_name,resource_name,query_name,ip_address,metadata
+log_1,2024-01-02T10:00:00Z,,jane@example.com,QUERY_RUN,,Orders,,,,"{""query"": ""getOrders""}"
+log_2,2024-01-01T09:00:00Z,,john@example.com,PAGE_VIEW,,"Refunds, EU",,,,
+`, buf.String())
+}
+
+func TestExportAuditLogs_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("next") == "" {
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "log_1"}], "has_more": true, "next_token": "page2"}`)
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprintln(w, `{"success": false, "message": "Insufficient scopes"}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	written, err := client.ExportAuditLogs(context.Background(), &buf, retool.AuditLogFormatCSV, nil)
+	assert.ErrorIs(t, err, retool.ErrForbidden)
+	assert.Equal(t, 1, written)
+	assert.Contains(t, buf.String(), "log_1")
+
+	_, err = client.ExportAuditLogs(context.Background(), &buf, "xml", nil)
+	assert.EqualError(t, err, "invalid audit log format: xml")
+}
@@ -2,9 +2,11 @@ package retoolsdk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
 	"time"
@@ -19,6 +21,14 @@ type Client struct {
 	Endpoint   string
 	BaseURL    string
 	HTTPClient *http.Client
+
+	retryPolicy  *RetryPolicy
+	rateLimiter  *transportWithRateLimit
+	logger       *slog.Logger
+	logBodies    bool
+	middlewares  []Middleware
+	server       serverState
+	environments environmentCache
 }
 
 // Response is the struct for the response from the Retool API
@@ -78,36 +88,101 @@ func NewClient(apiKey, endpoint string, opts ...ClientOption) (*Client, error) {
 		}
 	}
 
+	if c.rateLimiter != nil {
+		c.rateLimiter.Transport = customTransport.Transport
+		customTransport.Transport = c.rateLimiter
+	}
+
+	transport, err := chainMiddlewares(customTransport, c.middlewares)
+	if err != nil {
+		return nil, fmt.Errorf("applying client option: %w", err)
+	}
+	c.HTTPClient.Transport = transport
+
 	return c, nil
 }
 
-// Do makes an HTTP request to the Retool API.
-func (c *Client) Do(method, url string, body interface{}) (*http.Response, error) {
-	var requestBody []byte
-	var err error
+// RawBody is a request body that is not JSON, such as a multipart file upload. Do sends its data as is with its
+// content type.
+type RawBody struct {
+	ContentType string
+	Data        []byte
+}
 
-	if body != nil {
+// Do makes an HTTP request to the Retool API. The body is encoded as JSON, unless it is a []byte or
+// json.RawMessage holding already encoded JSON, which is sent as is, or a RawBody, which is sent with its own
+// content type. The request is bound to ctx, so cancelling ctx or exceeding its deadline aborts the request and
+// Do returns ctx.Err().
+// When a retry policy is configured, failed attempts are retried according to WithRetryPolicy, and every attempt
+// is logged when a logger is configured with WithLogger.
+func (c *Client) Do(ctx context.Context, method, url string, body interface{}) (*http.Response, error) {
+	var requestBody []byte
+	var contentType string
+
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		requestBody = b
+	case json.RawMessage:
+		requestBody = b
+	case RawBody:
+		requestBody = b.Data
+		contentType = b.ContentType
+	default:
+		var err error
 		requestBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("marshalling request: %w", err)
 		}
 	}
 
-	req, err := http.NewRequest(method, url, bytes.NewBuffer(requestBody))
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
-	}
+	attempts := c.retryPolicy.attempts(method)
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("making request: %w", err)
-	}
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(requestBody))
+		if err != nil {
+			return nil, fmt.Errorf("creating request: %w", err)
+		}
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+
+		start := time.Now()
+		resp, err := c.HTTPClient.Do(req)
+		resp = c.logRequest(ctx, req, requestBody, resp, err, attempt-1, time.Since(start))
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+		}
+
+		if attempt >= attempts || !shouldRetry(resp, err) {
+			if err != nil {
+				return nil, fmt.Errorf("making request: %w", err)
+			}
+			return resp, nil
+		}
 
-	return resp, nil
+		wait := c.retryPolicy.backoff(attempt, resp)
+
+		if c.retryPolicy.OnRetry != nil {
+			retry := RetryAttempt{Method: method, URL: url, Attempt: attempt, Err: err, Wait: wait}
+			if resp != nil {
+				retry.StatusCode = resp.StatusCode
+			}
+			c.retryPolicy.OnRetry(retry)
+		}
+
+		discardResponse(resp)
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
 }
 
 // RoundTrip adds the API key to the Authorization header for every request
-// and sets the Content-Type header to application/json.
+// and sets the Content-Type header to application/json unless the request already has one.
 func (t *transportWithAPIKey) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", t.APIKey))
 
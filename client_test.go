@@ -1,6 +1,8 @@
 package retoolsdk_test
 
 import (
+	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -96,3 +98,104 @@ func TestNewClient_AuthHeader(t *testing.T) {
 	_, err = client.HTTPClient.Do(req)
 	assert.NoError(t, err)
 }
+
+func TestDo_ContextCanceled(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client, err := retool.NewClient("test-api-key", mockServer.URL)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	resp, err := client.Do(ctx, "GET", mockServer.URL, nil)
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDo_ContextDeadlineExceeded(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer mockServer.Close()
+
+	client, err := retool.NewClient("test-api-key", mockServer.URL)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	resp, err := client.Do(ctx, "GET", mockServer.URL, nil)
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestDo_EncodedBody(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"operations": [{"op": "replace", "path": "first_name", "value": "Jane"}]}`, string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client, err := retool.NewClient("test-api-key", mockServer.URL)
+	assert.NoError(t, err)
+
+	_, err = client.Do(context.Background(), "PATCH", mockServer.URL, []byte(`{"operations": [{"op": "replace", "path": "first_name", "value": "Jane"}]}`))
+	assert.NoError(t, err)
+}
+
+func TestDo_RawBody(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+		assert.Equal(t, "plain text", string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	client, err := retool.NewClient("test-api-key", mockServer.URL)
+	assert.NoError(t, err)
+
+	_, err = client.Do(context.Background(), "POST", mockServer.URL, retool.RawBody{ContentType: "text/plain", Data: []byte("plain text")})
+	assert.NoError(t, err)
+}
+
+// TestDo_BodyEncoding covers how Do encodes every kind of body. Already encoded JSON ([]byte and
+// json.RawMessage) must be sent unchanged; marshalling it again would send a base64 JSON string instead.
+func TestDo_BodyEncoding(t *testing.T) {
+	tests := []struct {
+		name string
+		body interface{}
+		want string
+	}{
+		{"nil", nil, ``},
+		{"bytes", []byte(`{"name": "Jane"}`), `{"name": "Jane"}`},
+		{"raw message", json.RawMessage(`{"name": "Jane"}`), `{"name": "Jane"}`},
+		{"struct", struct {
+			Name string `json:"name"`
+		}{Name: "Jane"}, `{"name":"Jane"}`},
+		{"map", map[string]string{"name": "Jane"}, `{"name":"Jane"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, tt.want, string(body))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer mockServer.Close()
+
+			client, err := retool.NewClient("test-api-key", mockServer.URL)
+			assert.NoError(t, err)
+
+			_, err = client.Do(context.Background(), "POST", mockServer.URL, tt.body)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package retoolsdk
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 )
 
@@ -14,28 +16,63 @@ type ConfigurationVariable struct {
 	Values      []Value `json:"values"`
 }
 
+// Value is the value of a configuration variable in an environment. Either EnvironmentId or Environment, the name
+// of the environment, must be set; names are resolved to IDs by the client.
 type Value struct {
 	EnvironmentId string `json:"environment_id"`
+	Environment   string `json:"-"`
 	Value         string `json:"value"`
 }
 
 // GetConfigurationVariable available for orgs with configuration variables enabled on Retool Version 3.42+.
 // The API token must have the "Configuration Variables > Read" scope.
-func (c *Client) GetConfigurationVariable(id string) (*ConfigurationVariable, error) {
+func (c *Client) GetConfigurationVariable(ctx context.Context, id string) (*ConfigurationVariable, error) {
+	if err := c.requireFeature(ctx, FeatureConfigurationVariables); err != nil {
+		return nil, err
+	}
+
 	baseURL := fmt.Sprintf("%s/configuration_variables/%s", c.BaseURL, id)
-	return doSingleRequest[ConfigurationVariable](c, "GET", baseURL, nil)
+	return doSingleRequest[ConfigurationVariable](ctx, c, "GET", baseURL, nil)
 }
 
 // ListConfigurationVariables available for orgs with configuration variables enabled on Retool Version 3.42+.
 // The API token must have the "Configuration Variables > Read" scope.
-func (c *Client) ListConfigurationVariables() ([]ConfigurationVariable, error) {
+func (c *Client) ListConfigurationVariables(ctx context.Context) ([]ConfigurationVariable, error) {
+	if err := c.requireFeature(ctx, FeatureConfigurationVariables); err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/configuration_variables", c.BaseURL)
+	return doPaginatedRequest[ConfigurationVariable](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllConfigurationVariables returns an iterator over all configuration variables, fetching pages lazily as the iteration progresses.
+// Available on Retool Version 3.42+. The API token must have the "Configuration Variables > Read" scope.
+func (c *Client) AllConfigurationVariables(ctx context.Context) iter.Seq2[ConfigurationVariable, error] {
+	return paginateItems(c.ConfigurationVariablePages(ctx, ""))
+}
+
+// ConfigurationVariablePages returns an iterator over the pages of configuration variables, starting at the page identified by next
+// (or the first page when empty). Available on Retool Version 3.42+. The API token must have the "Configuration Variables > Read" scope.
+func (c *Client) ConfigurationVariablePages(ctx context.Context, next string) iter.Seq2[*Page[ConfigurationVariable], error] {
 	baseURL := fmt.Sprintf("%s/configuration_variables", c.BaseURL)
-	return doPaginatedRequest[ConfigurationVariable](c, "GET", baseURL, nil, url.Values{})
+	pages := paginatePages[ConfigurationVariable](ctx, c, "GET", baseURL, nil, url.Values{}, next)
+	return requirePages(ctx, c, FeatureConfigurationVariables, pages)
 }
 
 // CreateConfigurationVariable available for orgs with configuration variables enabled on Retool Version 3.42+.
+// Values naming their environment are resolved with EnvironmentID.
 // The API token must have the "Configuration Variables > Write" scope.
-func (c *Client) CreateConfigurationVariable(name, description string, secret bool, values []Value) (*ConfigurationVariable, error) {
+func (c *Client) CreateConfigurationVariable(ctx context.Context, name, description string, secret bool, values []Value) (*ConfigurationVariable, error) {
+	if err := c.requireFeature(ctx, FeatureConfigurationVariables); err != nil {
+		return nil, err
+	}
+
+	values, err := c.resolveEnvironments(ctx, values)
+	if err != nil {
+		return nil, err
+	}
+
 	requestBody := struct {
 		Name        string  `json:"name"`
 		Description string  `json:"description"`
@@ -54,12 +91,22 @@ func (c *Client) CreateConfigurationVariable(name, description string, secret bo
 	}
 
 	baseURL := fmt.Sprintf("%s/configuration_variables", c.BaseURL)
-	return doSingleRequest[ConfigurationVariable](c, "POST", baseURL, requestBodyJSON)
+	return doSingleRequest[ConfigurationVariable](ctx, c, "POST", baseURL, requestBodyJSON)
 }
 
 // UpdateConfigurationVariable update a configuration variable and its values. Available for orgs with configuration
-// variables enabled on Retool Version 3.42+. The API token must have the "Configuration Variables > Write" scope.
-func (c *Client) UpdateConfigurationVariable(id, name, description string, secret bool, values []Value) (*ConfigurationVariable, error) {
+// variables enabled on Retool Version 3.42+. Values naming their environment are resolved with EnvironmentID.
+// The API token must have the "Configuration Variables > Write" scope.
+func (c *Client) UpdateConfigurationVariable(ctx context.Context, id, name, description string, secret bool, values []Value) (*ConfigurationVariable, error) {
+	if err := c.requireFeature(ctx, FeatureConfigurationVariables); err != nil {
+		return nil, err
+	}
+
+	values, err := c.resolveEnvironments(ctx, values)
+	if err != nil {
+		return nil, err
+	}
+
 	requestBody := struct {
 		Name        string  `json:"name"`
 		Description string  `json:"description"`
@@ -78,13 +125,17 @@ func (c *Client) UpdateConfigurationVariable(id, name, description string, secre
 	}
 
 	baseURL := fmt.Sprintf("%s/configuration_variables/%s", c.BaseURL, id)
-	return doSingleRequest[ConfigurationVariable](c, "PUT", baseURL, requestBodyJSON)
+	return doSingleRequest[ConfigurationVariable](ctx, c, "PUT", baseURL, requestBodyJSON)
 }
 
 // DeleteConfigurationVariable deletes a configuration variable and its values. Available for orgs with configuration
 // variables enabled on Retool Version 3.42+. The API token must have the "Configuration Variables > Write" scope.
-func (c *Client) DeleteConfigurationVariable(id string) error {
+func (c *Client) DeleteConfigurationVariable(ctx context.Context, id string) error {
+	if err := c.requireFeature(ctx, FeatureConfigurationVariables); err != nil {
+		return err
+	}
+
 	baseURL := fmt.Sprintf("%s/configuration_variables/%s", c.BaseURL, id)
-	_, err := doSingleRequest[ConfigurationVariable](c, "DELETE", baseURL, nil)
+	_, err := doSingleRequest[ConfigurationVariable](ctx, c, "DELETE", baseURL, nil)
 	return err
 }
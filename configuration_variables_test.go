@@ -2,6 +2,7 @@ package retoolsdk_test
 
 import (
 	"bytes"
+	"context"
 	"github.com/stretchr/testify/assert"
 	retool "github.com/thoughtgears/retoolsdk"
 	"io"
@@ -38,7 +39,7 @@ func TestGetConfigurationVariable_Success(t *testing.T) {
 		},
 	}
 
-	configVar, err := client.GetConfigurationVariable("config_var_123")
+	configVar, err := client.GetConfigurationVariable(context.Background(), "config_var_123")
 	assert.NoError(t, err)
 	assert.NotNil(t, configVar)
 	assert.Equal(t, "Test Variable", configVar.Name)
@@ -63,7 +64,7 @@ func TestGetConfigurationVariable_Failure(t *testing.T) {
 		},
 	}
 
-	configVar, err := client.GetConfigurationVariable("config_var_123")
+	configVar, err := client.GetConfigurationVariable(context.Background(), "config_var_123")
 	assert.Error(t, err)
 	assert.Nil(t, configVar)
 }
@@ -99,7 +100,7 @@ func TestListConfigurationVariables_Success(t *testing.T) {
 		},
 	}
 
-	configVars, err := client.ListConfigurationVariables()
+	configVars, err := client.ListConfigurationVariables(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, configVars)
 	assert.Len(t, configVars, 1)
@@ -125,7 +126,7 @@ func TestListConfigurationVariables_Failure(t *testing.T) {
 		},
 	}
 
-	configVars, err := client.ListConfigurationVariables()
+	configVars, err := client.ListConfigurationVariables(context.Background())
 	assert.Error(t, err)
 	assert.Nil(t, configVars)
 }
@@ -152,14 +153,14 @@ func TestCreateConfigurationVariable_Success(t *testing.T) {
 		HTTPClient: &http.Client{
 			Transport: &MockTransport{
 				Response: &http.Response{
-					StatusCode: 404,
+					StatusCode: 200,
 					Body:       io.NopCloser(bytes.NewBuffer([]byte(response))),
 				},
 			},
 		},
 	}
 
-	configVar, err := client.CreateConfigurationVariable("Test Variable", "A test variable", false, []retool.Value{{EnvironmentId: "production", Value: "prod_value"}})
+	configVar, err := client.CreateConfigurationVariable(context.Background(), "Test Variable", "A test variable", false, []retool.Value{{EnvironmentId: "production", Value: "prod_value"}})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, configVar)
@@ -185,7 +186,7 @@ func TestCreateConfigurationVariable_Failure(t *testing.T) {
 		},
 	}
 
-	configVar, err := client.CreateConfigurationVariable("Test Variable", "A test variable", false, []retool.Value{{EnvironmentId: "production", Value: "prod_value"}})
+	configVar, err := client.CreateConfigurationVariable(context.Background(), "Test Variable", "A test variable", false, []retool.Value{{EnvironmentId: "production", Value: "prod_value"}})
 	assert.Error(t, err)
 	assert.Nil(t, configVar)
 }
@@ -212,14 +213,14 @@ func TestUpdateConfigurationVariable_Success(t *testing.T) {
 		HTTPClient: &http.Client{
 			Transport: &MockTransport{
 				Response: &http.Response{
-					StatusCode: 404,
+					StatusCode: 200,
 					Body:       io.NopCloser(bytes.NewBuffer([]byte(response))),
 				},
 			},
 		},
 	}
 
-	configVar, err := client.UpdateConfigurationVariable("config_var_123", "Test Variable", "A test variable", false, []retool.Value{{EnvironmentId: "production", Value: "prod_value"}})
+	configVar, err := client.UpdateConfigurationVariable(context.Background(), "config_var_123", "Test Variable", "A test variable", false, []retool.Value{{EnvironmentId: "production", Value: "prod_value"}})
 	assert.NoError(t, err)
 	assert.NotNil(t, configVar)
 	assert.Equal(t, "Test Variable", configVar.Name)
@@ -243,7 +244,7 @@ func TestUpdateConfigurationVariable_Failure(t *testing.T) {
 		},
 	}
 
-	configVar, err := client.UpdateConfigurationVariable("config_var_123", "Test Variable", "A test variable", false, []retool.Value{{EnvironmentId: "production", Value: "prod_value"}})
+	configVar, err := client.UpdateConfigurationVariable(context.Background(), "config_var_123", "Test Variable", "A test variable", false, []retool.Value{{EnvironmentId: "production", Value: "prod_value"}})
 	assert.Error(t, err)
 	assert.Nil(t, configVar)
 }
@@ -261,7 +262,7 @@ func TestClient_DeleteConfigurationVariable_Success(t *testing.T) {
 		},
 	}
 
-	err := client.DeleteConfigurationVariable("config_var_123")
+	err := client.DeleteConfigurationVariable(context.Background(), "config_var_123")
 	assert.NoError(t, err)
 }
 
@@ -283,6 +284,6 @@ func TestClient_DeleteConfigurationVariable_Failure(t *testing.T) {
 		},
 	}
 
-	err := client.DeleteConfigurationVariable("config_var_123")
+	err := client.DeleteConfigurationVariable(context.Background(), "config_var_123")
 	assert.Error(t, err)
 }
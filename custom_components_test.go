@@ -0,0 +1,169 @@
+package retoolsdk_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// gzipData compresses data with gzip.
+func gzipData(t *testing.T, data string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(data))
+	assert.NoError(t, err)
+	assert.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestCreateCustomComponentLibrary_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/custom_component_libraries", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "Charts", "label": "Charts", "description": "Chart components"}`, string(body))
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "lib_1", "name": "Charts", "label": "Charts"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	library, err := client.CreateCustomComponentLibrary(context.Background(), "Charts", "Charts", "Chart components")
+	assert.NoError(t, err)
+	assert.Equal(t, "lib_1", library.ID)
+}
+
+func TestCreateCustomComponentLibrary_Invalid(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://retool.example.com")
+	assert.NoError(t, err)
+
+	_, err = client.CreateCustomComponentLibrary(context.Background(), "my-charts", "Charts", "")
+	assert.EqualError(t, err, `invalid library name: "my-charts"`)
+
+	_, err = client.CreateCustomComponentLibrary(context.Background(), "Charts", "", "")
+	assert.EqualError(t, err, "library label cannot be empty")
+}
+
+func TestAllLibraryRevisions_Pages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/custom_component_libraries/lib_1/revisions", r.URL.Path)
+		switch r.URL.Query().Get("next") {
+		case "":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "rev_2", "library_id": "lib_1", "version": 2}], "total_count": 2, "has_more": true, "next_token": "page2"}`)
+		case "page2":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "rev_1", "library_id": "lib_1", "version": 1}], "total_count": 2, "has_more": false}`)
+		}
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	var versions []int
+	for revision, err := range client.AllLibraryRevisions(context.Background(), "lib_1") {
+		assert.NoError(t, err)
+		versions = append(versions, revision.Version)
+	}
+	assert.Equal(t, []int{2, 1}, versions)
+}
+
+func TestUploadLibraryRevision_Multipart(t *testing.T) {
+	bundle := gzipData(t, "bundle contents")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/custom_component_libraries/lib_1/revisions", r.URL.Path)
+		assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data; boundary="))
+
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+		file, header, err := r.FormFile("bundle")
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer file.Close()
+
+		assert.Equal(t, "bundle.tar.gz", header.Filename)
+		assert.Equal(t, "application/gzip", header.Header.Get("Content-Type"))
+		data, _ := io.ReadAll(file)
+		assert.Equal(t, bundle, data)
+
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "rev_3", "library_id": "lib_1", "version": 3}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	revision, err := client.UploadLibraryRevision(context.Background(), "lib_1", bytes.NewReader(bundle))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, revision.Version)
+}
+
+func TestUploadLibraryRevision_Invalid(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://retool.example.com")
+	assert.NoError(t, err)
+
+	_, err = client.UploadLibraryRevision(context.Background(), "lib_1", nil)
+	assert.EqualError(t, err, "bundle cannot be nil")
+
+	_, err = client.UploadLibraryRevision(context.Background(), "lib_1", strings.NewReader("not gzip"))
+	assert.EqualError(t, err, "bundle is not gzip-compressed")
+}
+
+func TestPackageLibraryRevision(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "assets"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "index.js"), []byte("export {}"), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "assets", "style.css"), []byte("body {}"), 0o644))
+
+	bundle, err := retool.PackageLibraryRevision(dir)
+	assert.NoError(t, err)
+
+	gz, err := gzip.NewReader(bytes.NewReader(bundle))
+	assert.NoError(t, err)
+	archive := tar.NewReader(gz)
+
+	files := make(map[string]string)
+	var names []string
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		data, _ := io.ReadAll(archive)
+		names = append(names, header.Name)
+		files[header.Name] = string(data)
+	}
+	assert.Equal(t, []string{"assets/style.css", "index.js"}, names)
+	assert.Equal(t, "export {}", files["index.js"])
+
+	again, err := retool.PackageLibraryRevision(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, bundle, again)
+}
+
+func TestPackageLibraryRevision_Invalid(t *testing.T) {
+	_, err := retool.PackageLibraryRevision(t.TempDir())
+	assert.EqualError(t, err, "packaging build: no files found")
+
+	file := filepath.Join(t.TempDir(), "index.js")
+	assert.NoError(t, os.WriteFile(file, []byte("export {}"), 0o644))
+	_, err = retool.PackageLibraryRevision(file)
+	assert.EqualError(t, err, fmt.Sprintf("packaging build: %s is not a directory", file))
+}
@@ -0,0 +1,139 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const landingPageUUID = "8f2c7c3e-5a8b-11ee-8c99-0242ac120002"
+
+func TestCreateEmbedURL_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/embed-url/external-user", r.URL.Path)
+		assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"landingPageUuid":        landingPageUUID,
+			"externalIdentifier":     "customer-42",
+			"groupIds":               []interface{}{float64(7)},
+			"userInfo":               map[string]interface{}{"firstName": "Jane", "email": "jane@example.com"},
+			"metadata":               map[string]interface{}{"plan": "enterprise"},
+			"sessionDurationMinutes": float64(30),
+		}, body)
+
+		fmt.Fprintln(w, `{"embedUrl": "https://retool.example.com/embedded/authed/abc", "expiresAt": "2024-01-01T00:05:00Z"}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	embedURL, err := client.CreateEmbedURL(context.Background(), &retool.EmbedURLRequest{
+		LandingPageUUID:    landingPageUUID,
+		ExternalIdentifier: "customer-42",
+		GroupIDs:           []int{7},
+		UserInfo:           &retool.EmbedUserInfo{FirstName: "Jane", Email: "jane@example.com"},
+		Metadata:           map[string]interface{}{"plan": "enterprise"},
+		SessionDuration:    30 * time.Minute,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://retool.example.com/embedded/authed/abc", embedURL.URL)
+	assert.Equal(t, "2024-01-01T00:05:00Z", embedURL.ExpiresAt)
+}
+
+func TestCreateEmbedURL_WithoutExpiry(t *testing.T) {
+	responses := []string{
+		`{"embedUrl": "https://retool.example.com/embedded/authed/abc"}`,
+		`{"embedUrl": "https://retool.example.com/embedded/authed/abc", "expiresAt": ""}`,
+	}
+
+	for _, response := range responses {
+		t.Run(response, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintln(w, response)
+			}))
+			defer server.Close()
+
+			client, err := retool.NewClient("test-api-key", server.URL)
+			assert.NoError(t, err)
+
+			embedURL, err := client.CreateEmbedURL(context.Background(), &retool.EmbedURLRequest{
+				LandingPageUUID:    landingPageUUID,
+				ExternalIdentifier: "customer-42",
+				GroupIDs:           []int{7},
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "https://retool.example.com/embedded/authed/abc", embedURL.URL)
+			assert.Empty(t, embedURL.ExpiresAt)
+		})
+	}
+}
+
+func TestCreateEmbedURL_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprintln(w, `{"message": "Group 7 does not have access to the app"}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	_, err = client.CreateEmbedURL(context.Background(), &retool.EmbedURLRequest{
+		LandingPageUUID:    landingPageUUID,
+		ExternalIdentifier: "customer-42",
+		GroupIDs:           []int{7},
+	})
+	assert.ErrorIs(t, err, retool.ErrForbidden)
+	assert.Contains(t, err.Error(), "Group 7 does not have access to the app")
+}
+
+func TestEmbedURLRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		request retool.EmbedURLRequest
+		err     string
+	}{
+		{"valid", retool.EmbedURLRequest{LandingPageUUID: landingPageUUID, ExternalIdentifier: "customer-42", GroupIDs: []int{7}}, ""},
+		{"missing landing page", retool.EmbedURLRequest{ExternalIdentifier: "customer-42", GroupIDs: []int{7}}, "landing page uuid is required"},
+		{"invalid landing page", retool.EmbedURLRequest{LandingPageUUID: "app_123", ExternalIdentifier: "customer-42", GroupIDs: []int{7}}, "invalid landing page uuid: app_123"},
+		{"missing external identifier", retool.EmbedURLRequest{LandingPageUUID: landingPageUUID, GroupIDs: []int{7}}, "external identifier is required"},
+		{"missing groups", retool.EmbedURLRequest{LandingPageUUID: landingPageUUID, ExternalIdentifier: "customer-42"}, "at least one group id is required"},
+		{"invalid email", retool.EmbedURLRequest{LandingPageUUID: landingPageUUID, ExternalIdentifier: "customer-42", GroupIDs: []int{7},
+			UserInfo: &retool.EmbedUserInfo{Email: "jane"}}, "invalid email: jane"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestCreateEmbedURL_ValidationFailure(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://example.com")
+	assert.NoError(t, err)
+
+	_, err = client.CreateEmbedURL(context.Background(), &retool.EmbedURLRequest{LandingPageUUID: landingPageUUID})
+	assert.EqualError(t, err, "validating embed url request: external identifier is required")
+
+	_, err = client.CreateEmbedURL(context.Background(), nil)
+	assert.EqualError(t, err, "embed url request cannot be nil")
+}
@@ -0,0 +1,218 @@
+package retoolsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnknownEnvironment is returned when an environment name does not match any environment of the organization.
+var ErrUnknownEnvironment = errors.New("unknown environment")
+
+// Environment is a struct that contains the information about an environment.
+type Environment struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Color       string `json:"color"`
+	Default     bool   `json:"default"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// environmentRefreshInterval is the minimum time between listings of the environments triggered by names missing
+// from the cache, so that lookups of unknown names do not list the environments on every call.
+const environmentRefreshInterval = 30 * time.Second
+
+// environmentCache caches the environment IDs by lower-cased name. listing is closed when the listing in flight
+// completes, so concurrent lookups wait for it instead of listing again. generation is incremented on every
+// invalidation, so that a listing started before environments were changed is not cached.
+type environmentCache struct {
+	mu         sync.Mutex
+	ids        map[string]string
+	listedAt   time.Time
+	listing    chan struct{}
+	generation int
+}
+
+// invalidate clears the cache after environments are changed through the client.
+func (e *environmentCache) invalidate() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.ids = nil
+	e.generation++
+}
+
+// GetEnvironment returns the environment with the given ID. The API token must have the "Environments > Read" scope.
+func (c *Client) GetEnvironment(ctx context.Context, id string) (*Environment, error) {
+	baseURL := fmt.Sprintf("%s/environments/%s", c.BaseURL, id)
+	return doSingleRequest[Environment](ctx, c, "GET", baseURL, nil)
+}
+
+// ListEnvironments returns a list of environments. The API token must have the "Environments > Read" scope.
+func (c *Client) ListEnvironments(ctx context.Context) ([]Environment, error) {
+	baseURL := fmt.Sprintf("%s/environments", c.BaseURL)
+	return doPaginatedRequest[Environment](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllEnvironments returns an iterator over all environments, fetching pages lazily as the iteration progresses.
+// The API token must have the "Environments > Read" scope.
+func (c *Client) AllEnvironments(ctx context.Context) iter.Seq2[Environment, error] {
+	return paginateItems(c.EnvironmentPages(ctx, ""))
+}
+
+// EnvironmentPages returns an iterator over the pages of environments, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Environments > Read" scope.
+func (c *Client) EnvironmentPages(ctx context.Context, next string) iter.Seq2[*Page[Environment], error] {
+	baseURL := fmt.Sprintf("%s/environments", c.BaseURL)
+	return paginatePages[Environment](ctx, c, "GET", baseURL, nil, url.Values{}, next)
+}
+
+// CreateEnvironment creates an environment and returns it. Color is a hex color such as "#3C92DC".
+// The API token must have the "Environments > Write" scope.
+func (c *Client) CreateEnvironment(ctx context.Context, name, description, color string) (*Environment, error) {
+	if name == "" {
+		return nil, errors.New("environment name cannot be empty")
+	}
+
+	requestBody := struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Color       string `json:"color,omitempty"`
+	}{
+		Name:        name,
+		Description: description,
+		Color:       color,
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	defer c.environments.invalidate()
+
+	baseURL := fmt.Sprintf("%s/environments", c.BaseURL)
+	return doSingleRequest[Environment](ctx, c, "POST", baseURL, requestBodyJSON)
+}
+
+// UpdateEnvironment updates and returns the updated environment. The API token must have the "Environments > Write" scope.
+func (c *Client) UpdateEnvironment(ctx context.Context, id string, operations []UpdateOperations) (*Environment, error) {
+	if len(operations) == 0 {
+		return nil, errors.New("no operations provided")
+	}
+
+	for _, op := range operations {
+		if err := op.Validate(); err != nil {
+			return nil, fmt.Errorf("validation failed for operation: %w", err)
+		}
+	}
+
+	requestBody := struct {
+		Operations []UpdateOperations `json:"operations"`
+	}{
+		Operations: operations,
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	defer c.environments.invalidate()
+
+	baseURL := fmt.Sprintf("%s/environments/%s", c.BaseURL, id)
+	return doSingleRequest[Environment](ctx, c, "PATCH", baseURL, requestBodyJSON)
+}
+
+// DeleteEnvironment deletes the environment with the given ID. The API token must have the "Environments > Write" scope.
+func (c *Client) DeleteEnvironment(ctx context.Context, id string) error {
+	defer c.environments.invalidate()
+
+	baseURL := fmt.Sprintf("%s/environments/%s", c.BaseURL, id)
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
+	return err
+}
+
+// EnvironmentID returns the ID of the environment with the given name, compared case-insensitively. The environments
+// are listed once and cached; a name missing from the cache lists them again, at most every 30 seconds, so
+// environments created elsewhere are found. Concurrent lookups share a single listing.
+// The API token must have the "Environments > Read" scope.
+func (c *Client) EnvironmentID(ctx context.Context, name string) (string, error) {
+	key := strings.ToLower(name)
+	cache := &c.environments
+
+	for {
+		cache.mu.Lock()
+
+		if id, ok := cache.ids[key]; ok {
+			cache.mu.Unlock()
+			return id, nil
+		}
+
+		if listing := cache.listing; listing != nil {
+			cache.mu.Unlock()
+
+			select {
+			case <-listing:
+				continue
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+		}
+
+		if cache.ids != nil && time.Since(cache.listedAt) < environmentRefreshInterval {
+			cache.mu.Unlock()
+			return "", fmt.Errorf("%w: %s", ErrUnknownEnvironment, name)
+		}
+
+		listing := make(chan struct{})
+		cache.listing = listing
+		generation := cache.generation
+		cache.mu.Unlock()
+
+		environments, err := c.ListEnvironments(ctx)
+
+		cache.mu.Lock()
+		if err == nil && generation == cache.generation {
+			cache.ids = make(map[string]string, len(environments))
+			for _, environment := range environments {
+				cache.ids[strings.ToLower(environment.Name)] = environment.ID
+			}
+			cache.listedAt = time.Now()
+		}
+		cache.listing = nil
+		close(listing)
+		cache.mu.Unlock()
+
+		if err != nil {
+			return "", fmt.Errorf("listing environments: %w", err)
+		}
+	}
+}
+
+// resolveEnvironments returns a copy of the values with the environment ID of every value that only names its
+// environment filled in.
+func (c *Client) resolveEnvironments(ctx context.Context, values []Value) ([]Value, error) {
+	resolved := make([]Value, len(values))
+
+	for i, value := range values {
+		if value.EnvironmentId == "" && value.Environment != "" {
+			id, err := c.EnvironmentID(ctx, value.Environment)
+			if err != nil {
+				return nil, fmt.Errorf("resolving environment %q: %w", value.Environment, err)
+			}
+			value.EnvironmentId = id
+		}
+		resolved[i] = value
+	}
+
+	return resolved, nil
+}
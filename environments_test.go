@@ -0,0 +1,216 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEnvironment_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/environments/env_123", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "env_123", "name": "production", "color": "#3C92DC", "default": true}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	environment, err := client.GetEnvironment(context.Background(), "env_123")
+	assert.NoError(t, err)
+	assert.Equal(t, &retool.Environment{ID: "env_123", Name: "production", Color: "#3C92DC", Default: true}, environment)
+}
+
+func TestCreateEnvironment_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"name": "staging", "description": "Pre-production", "color": "#E9AB11"}, body)
+
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "env_456", "name": "staging"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	environment, err := client.CreateEnvironment(context.Background(), "staging", "Pre-production", "#E9AB11")
+	assert.NoError(t, err)
+	assert.Equal(t, "env_456", environment.ID)
+
+	_, err = client.CreateEnvironment(context.Background(), "", "", "")
+	assert.EqualError(t, err, "environment name cannot be empty")
+}
+
+func TestUpdateEnvironment_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/v2/environments/env_456", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "env_456", "name": "qa"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	environment, err := client.UpdateEnvironment(context.Background(), "env_456", []retool.UpdateOperations{{Op: "replace", Path: "/name", Value: "qa"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "qa", environment.Name)
+
+	_, err = client.UpdateEnvironment(context.Background(), "env_456", nil)
+	assert.EqualError(t, err, "no operations provided")
+}
+
+func TestDeleteEnvironment_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.DeleteEnvironment(context.Background(), "env_456"))
+}
+
+func TestEnvironmentID_Cached(t *testing.T) {
+	var lists atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lists.Add(1)
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "env_prod", "name": "Production"}, {"id": "env_staging", "name": "staging"}], "total_count": 2, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	id, err := client.EnvironmentID(context.Background(), "production")
+	assert.NoError(t, err)
+	assert.Equal(t, "env_prod", id)
+
+	id, err = client.EnvironmentID(context.Background(), "staging")
+	assert.NoError(t, err)
+	assert.Equal(t, "env_staging", id)
+	assert.Equal(t, int32(1), lists.Load())
+
+	for range 3 {
+		_, err = client.EnvironmentID(context.Background(), "qa")
+		assert.ErrorIs(t, err, retool.ErrUnknownEnvironment)
+		assert.EqualError(t, err, "unknown environment: qa")
+	}
+	assert.Equal(t, int32(1), lists.Load())
+}
+
+func TestEnvironmentID_InvalidatedByChanges(t *testing.T) {
+	var lists atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		lists.Add(1)
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "env_prod", "name": "production"}], "total_count": 1, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	_, err = client.EnvironmentID(context.Background(), "qa")
+	assert.ErrorIs(t, err, retool.ErrUnknownEnvironment)
+
+	assert.NoError(t, client.DeleteEnvironment(context.Background(), "env_qa"))
+
+	_, err = client.EnvironmentID(context.Background(), "qa")
+	assert.ErrorIs(t, err, retool.ErrUnknownEnvironment)
+	assert.Equal(t, int32(2), lists.Load())
+}
+
+func TestEnvironmentID_ConcurrentLookupsShareListing(t *testing.T) {
+	var lists atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lists.Add(1)
+		<-release
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "env_prod", "name": "production"}], "total_count": 1, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := client.EnvironmentID(context.Background(), "production")
+			assert.NoError(t, err)
+			assert.Equal(t, "env_prod", id)
+		}()
+	}
+
+	assert.Eventually(t, func() bool { return lists.Load() == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = client.EnvironmentID(ctx, "staging")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), lists.Load())
+}
+
+func TestCreateConfigurationVariable_EnvironmentNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/environments":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "env_prod", "name": "production"}], "total_count": 1, "has_more": false}`)
+		case "/api/v2/configuration_variables":
+			var body struct {
+				Values []map[string]string `json:"values"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []map[string]string{
+				{"environment_id": "env_prod", "value": "https://api.example.com"},
+				{"environment_id": "env_explicit", "value": "https://staging.example.com"},
+			}, body.Values)
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "config_var_123", "name": "API_URL"}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	values := []retool.Value{
+		{Environment: "production", Value: "https://api.example.com"},
+		{EnvironmentId: "env_explicit", Value: "https://staging.example.com"},
+	}
+
+	configVar, err := client.CreateConfigurationVariable(context.Background(), "API_URL", "", false, values)
+	assert.NoError(t, err)
+	assert.Equal(t, "config_var_123", configVar.Id)
+	assert.Empty(t, values[0].EnvironmentId)
+
+	_, err = client.UpdateConfigurationVariable(context.Background(), "config_var_123", "API_URL", "", false,
+		[]retool.Value{{Environment: "qa", Value: "https://qa.example.com"}})
+	assert.ErrorIs(t, err, retool.ErrUnknownEnvironment)
+}
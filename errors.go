@@ -0,0 +1,91 @@
+package retoolsdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/thoughtgears/retoolsdk/internal/apierror"
+)
+
+// Sentinel errors matched by *APIError with errors.Is based on the HTTP status code of the response.
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServerError  = errors.New("server error")
+)
+
+// APIError is returned when the Retool API responds with an error status code or an unsuccessful response.
+// StatusCode is the HTTP status code of the response.
+// Message is the message returned by Retool, if the body could be decoded.
+// Body is a snippet of the raw response body, useful when the body is not JSON (e.g. an HTML page from a proxy).
+// Method and URL identify the request that failed.
+// RequestID is the value of the X-Request-Id response header, if present.
+type APIError struct {
+	StatusCode int
+	Message    string
+	Body       string
+	Method     string
+	URL        string
+	RequestID  string
+}
+
+// Error returns the message returned by Retool, or a description of the failed request when there is none.
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+
+	var b strings.Builder
+	b.WriteString("retool API error")
+	if e.Method != "" {
+		fmt.Fprintf(&b, ": %s %s", e.Method, e.URL)
+	}
+	fmt.Fprintf(&b, ": %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Body != "" {
+		fmt.Fprintf(&b, ": %s", e.Body)
+	}
+
+	return b.String()
+}
+
+// Is reports whether the error matches one of the sentinel errors for its status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+
+	return false
+}
+
+// newAPIError builds an APIError from the response and its already read body.
+func newAPIError(resp *http.Response, body []byte, message string) *APIError {
+	details := apierror.Describe(resp, body)
+
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    message,
+		Body:       details.Body,
+		Method:     details.Method,
+		URL:        details.URL,
+		RequestID:  details.RequestID,
+	}
+}
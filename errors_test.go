@@ -0,0 +1,135 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError_JSONMessage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req_123")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"success": false, "message": "No user 'user_123' found for organization"}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	user, err := client.GetUser(context.Background(), "user_123")
+	assert.Nil(t, user)
+	assert.ErrorIs(t, err, retool.ErrNotFound)
+	assert.NotErrorIs(t, err, retool.ErrForbidden)
+	assert.Equal(t, "No user 'user_123' found for organization", err.Error())
+
+	var apiErr *retool.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "req_123", apiErr.RequestID)
+	assert.Equal(t, "GET", apiErr.Method)
+	assert.Equal(t, server.URL+"/api/v2/users/user_123", apiErr.URL)
+}
+
+func TestAPIError_NonJSONBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, "<html><body>502 Bad Gateway</body></html>")
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	groups, err := client.ListGroups(context.Background())
+	assert.Nil(t, groups)
+	assert.ErrorIs(t, err, retool.ErrServerError)
+
+	var apiErr *retool.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	assert.Empty(t, apiErr.Message)
+	assert.Equal(t, "<html><body>502 Bad Gateway</body></html>", apiErr.Body)
+	assert.Contains(t, err.Error(), "502 Bad Gateway")
+}
+
+func TestAPIError_TruncatesBodyAtRuneBoundary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, "a"+strings.Repeat("é", 1024))
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	_, err = client.ListGroups(context.Background())
+
+	var apiErr *retool.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.True(t, utf8.ValidString(apiErr.Body))
+	assert.Equal(t, "a"+strings.Repeat("é", 511)+"...", apiErr.Body)
+}
+
+func TestAPIError_EmptyBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	_, err = client.DeleteUserAttribute(context.Background(), "user_123", "attribute1")
+	assert.ErrorIs(t, err, retool.ErrUnauthorized)
+	assert.Equal(t, fmt.Sprintf("retool API error: DELETE %s/api/v2/users/user_123/user_attributes/attribute1: 401 Unauthorized", server.URL), err.Error())
+}
+
+func TestAPIError_UnsuccessfulResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": false, "message": "Invalid attributes"}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	_, err = client.UpdateUserAttributes(context.Background(), "user_123", []retool.UserAttribute{{Name: "a", Value: "b"}})
+
+	var apiErr *retool.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusOK, apiErr.StatusCode)
+	assert.Equal(t, "Invalid attributes", apiErr.Message)
+}
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		target     error
+	}{
+		{http.StatusBadRequest, retool.ErrBadRequest},
+		{http.StatusUnauthorized, retool.ErrUnauthorized},
+		{http.StatusForbidden, retool.ErrForbidden},
+		{http.StatusNotFound, retool.ErrNotFound},
+		{http.StatusConflict, retool.ErrConflict},
+		{http.StatusTooManyRequests, retool.ErrRateLimited},
+		{http.StatusServiceUnavailable, retool.ErrServerError},
+	}
+
+	for _, tt := range tests {
+		err := fmt.Errorf("wrapped: %w", &retool.APIError{StatusCode: tt.statusCode})
+		assert.ErrorIs(t, err, tt.target, "status %d", tt.statusCode)
+	}
+
+	assert.NotErrorIs(t, &retool.APIError{StatusCode: http.StatusOK}, retool.ErrServerError)
+}
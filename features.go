@@ -0,0 +1,325 @@
+package retoolsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrUnsupportedByServer is returned by version-dependent methods when the Retool instance is too old to
+// support them. The request is not sent.
+var ErrUnsupportedByServer = errors.New("unsupported by server")
+
+// Feature is a version-dependent capability of the Retool API.
+type Feature string
+
+// Features that depend on the version of self-hosted Retool instances.
+const (
+	FeatureUserAttributes         Feature = "user_attributes"
+	FeatureConfigurationVariables Feature = "configuration_variables"
+	FeatureFolderPermissions      Feature = "folder_permissions"
+	FeatureAppPermissions         Feature = "app_permissions"
+	FeatureResourcePermissions    Feature = "resource_permissions"
+	FeatureAccessList             Feature = "access_list"
+)
+
+func (f Feature) String() string {
+	return string(f)
+}
+
+// featureRequirement is the minimum version supporting a feature on the edge and stable release channels.
+type featureRequirement struct {
+	edge   serverVersion
+	stable serverVersion
+}
+
+// featureRequirements lists the minimum on-prem versions documented for every feature.
+var featureRequirements = map[Feature]featureRequirement{
+	FeatureUserAttributes:         {edge: serverVersion{3, 20, 1, ""}, stable: serverVersion{3, 20, 1, ""}},
+	FeatureConfigurationVariables: {edge: serverVersion{3, 42, 0, ""}, stable: serverVersion{3, 42, 0, ""}},
+	FeatureFolderPermissions:      {edge: serverVersion{3, 18, 0, ""}, stable: serverVersion{3, 18, 0, ""}},
+	FeatureAppPermissions:         {edge: serverVersion{3, 26, 0, ""}, stable: serverVersion{3, 26, 0, ""}},
+	FeatureResourcePermissions:    {edge: serverVersion{3, 37, 0, ""}, stable: serverVersion{3, 47, 0, ""}},
+	FeatureAccessList:             {edge: serverVersion{3, 96, 0, ""}, stable: serverVersion{3, 114, 0, ""}},
+}
+
+// ServerInfo describes the Retool instance the client talks to.
+// Version is the version reported by a self-hosted instance (e.g. "3.114.2-stable"). It is empty when the
+// instance does not report its version, in which case every feature is assumed to be supported.
+// Cloud is true for Retool Cloud instances, which always run the latest version.
+type ServerInfo struct {
+	Version string
+	Cloud   bool
+}
+
+// serverState caches the detected server information. probe is closed when the probe in flight completes, so
+// concurrent callers wait for it instead of probing again.
+type serverState struct {
+	mu      sync.Mutex
+	info    *ServerInfo
+	probe   chan struct{}
+	enabled bool
+}
+
+// WithFeatureDetection makes version-dependent methods probe the server version once, cache it and return
+// ErrUnsupportedByServer before sending requests the server does not support.
+func WithFeatureDetection() ClientOption {
+	return func(c *Client) error {
+		c.server.enabled = true
+		return nil
+	}
+}
+
+// WithServerVersion sets the version of the Retool instance instead of probing it, and enables the same
+// checks as WithFeatureDetection. The version has the format "3.114.2", optionally followed by "-stable" or "-edge".
+func WithServerVersion(version string) ClientOption {
+	return func(c *Client) error {
+		if _, err := parseServerVersion(version); err != nil {
+			return err
+		}
+
+		c.server.enabled = true
+		c.server.info = &ServerInfo{Version: version}
+		return nil
+	}
+}
+
+// serverInfoResponse is the body returned by the health check endpoint of self-hosted instances.
+type serverInfoResponse struct {
+	Version string `json:"version"`
+}
+
+// ServerInfo returns information about the Retool instance. Retool Cloud instances are recognized by their
+// domain, self-hosted instances are probed through the health check endpoint, which reports the version
+// in the X-Retool-Version header or the "version" field of its body. When the health check responds with an error
+// status the version is unknown, which is cached like a detected version so that gated calls do not probe again.
+// A probe that fails without a response, such as on a network error or a canceled context, returns its error and is
+// retried on the next call. Concurrent callers share a single probe.
+func (c *Client) ServerInfo(ctx context.Context) (*ServerInfo, error) {
+	for {
+		c.server.mu.Lock()
+
+		if c.server.info != nil {
+			result := *c.server.info
+			c.server.mu.Unlock()
+			return &result, nil
+		}
+
+		if probe := c.server.probe; probe != nil {
+			c.server.mu.Unlock()
+
+			select {
+			case <-probe:
+				continue
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		probe := make(chan struct{})
+		c.server.probe = probe
+		c.server.mu.Unlock()
+
+		info, err := c.probeServerInfo(ctx)
+
+		c.server.mu.Lock()
+		if err == nil {
+			c.server.info = info
+		}
+		c.server.probe = nil
+		close(probe)
+		c.server.mu.Unlock()
+
+		if err != nil {
+			return nil, err
+		}
+
+		result := *info
+		return &result, nil
+	}
+}
+
+// probeServerInfo detects the server information without caching it. An error status of the health check yields
+// an unknown version.
+func (c *Client) probeServerInfo(ctx context.Context) (*ServerInfo, error) {
+	endpoint, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing endpoint: %w", err)
+	}
+
+	if host := endpoint.Hostname(); host == "retool.com" || strings.HasSuffix(host, ".retool.com") {
+		return &ServerInfo{Cloud: true}, nil
+	}
+
+	resp, err := c.Do(ctx, "GET", fmt.Sprintf("%s/api/checkHealth", c.Endpoint), nil)
+	if err != nil {
+		return nil, fmt.Errorf("making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return &ServerInfo{}, nil
+	}
+
+	info := &ServerInfo{Version: resp.Header.Get("X-Retool-Version")}
+
+	if info.Version == "" {
+		var response serverInfoResponse
+		if err := json.Unmarshal(body, &response); err == nil {
+			info.Version = response.Version
+		}
+	}
+
+	if _, err := parseServerVersion(info.Version); err != nil {
+		info.Version = ""
+	}
+
+	return info, nil
+}
+
+// Supports reports whether the Retool instance supports the feature.
+func (c *Client) Supports(ctx context.Context, feature Feature) (bool, error) {
+	requirement, ok := featureRequirements[feature]
+	if !ok {
+		return false, fmt.Errorf("unknown feature: %s", feature)
+	}
+
+	info, err := c.ServerInfo(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if info.Cloud || info.Version == "" {
+		return true, nil
+	}
+
+	version, err := parseServerVersion(info.Version)
+	if err != nil {
+		return false, err
+	}
+
+	minimum := requirement.edge
+	if version.channel == "stable" {
+		minimum = requirement.stable
+	}
+
+	return !version.less(minimum), nil
+}
+
+// requireFeature returns ErrUnsupportedByServer when feature detection is enabled and the server does not
+// support the feature. When the server version cannot be detected the request is allowed.
+func (c *Client) requireFeature(ctx context.Context, feature Feature) error {
+	if !c.server.enabled {
+		return nil
+	}
+
+	supported, err := c.Supports(ctx, feature)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return nil
+	}
+
+	if !supported {
+		info, _ := c.ServerInfo(ctx)
+		requirement := featureRequirements[feature]
+
+		minimum := requirement.edge.String()
+		if requirement.stable != requirement.edge {
+			minimum = fmt.Sprintf("%s (edge) or %s (stable)", requirement.edge, requirement.stable)
+		}
+
+		return fmt.Errorf("%w: %s requires Retool %s or later, server runs %s",
+			ErrUnsupportedByServer, feature, minimum, info.Version)
+	}
+
+	return nil
+}
+
+// requirePages returns a page iterator that yields ErrUnsupportedByServer instead of fetching any page
+// when the server does not support the feature.
+func requirePages[T any](ctx context.Context, c *Client, feature Feature, pages iter.Seq2[*Page[T], error]) iter.Seq2[*Page[T], error] {
+	return func(yield func(*Page[T], error) bool) {
+		if err := c.requireFeature(ctx, feature); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		for page, err := range pages {
+			if !yield(page, err) {
+				return
+			}
+		}
+	}
+}
+
+// objectTypeFeature returns the feature required to manage permissions on the object type.
+func objectTypeFeature(objectType ObjectType) Feature {
+	switch objectType {
+	case AppObject:
+		return FeatureAppPermissions
+	case ResourceObject, ResourceConfigurationObject:
+		return FeatureResourcePermissions
+	default:
+		return FeatureFolderPermissions
+	}
+}
+
+// serverVersion is a parsed Retool version such as "3.114.2-stable".
+type serverVersion struct {
+	major, minor, patch int
+	channel             string
+}
+
+func (v serverVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch)
+}
+
+// less reports whether v is an older version than other, ignoring the release channel.
+func (v serverVersion) less(other serverVersion) bool {
+	if v.major != other.major {
+		return v.major < other.major
+	}
+	if v.minor != other.minor {
+		return v.minor < other.minor
+	}
+	return v.patch < other.patch
+}
+
+// parseServerVersion parses versions in the format "3.114.2", "v3.114.2" or "3.114.2-stable".
+func parseServerVersion(version string) (serverVersion, error) {
+	var parsed serverVersion
+
+	number, channel, _ := strings.Cut(strings.TrimPrefix(version, "v"), "-")
+	parsed.channel = channel
+
+	parts := strings.Split(number, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return parsed, fmt.Errorf("invalid server version: %q", version)
+	}
+
+	fields := []*int{&parsed.major, &parsed.minor, &parsed.patch}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return parsed, fmt.Errorf("invalid server version: %q", version)
+		}
+		*fields[i] = n
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,254 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithServerVersion_BlocksUnsupportedFeature(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		fmt.Fprintln(w, `{"success": true, "data": []}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithServerVersion("3.40.1-stable"))
+	assert.NoError(t, err)
+
+	configVars, err := client.ListConfigurationVariables(context.Background())
+	assert.Nil(t, configVars)
+	assert.ErrorIs(t, err, retool.ErrUnsupportedByServer)
+	assert.EqualError(t, err, "unsupported by server: configuration_variables requires Retool 3.42.0 or later, server runs 3.40.1-stable")
+
+	for _, err := range client.AllConfigurationVariables(context.Background()) {
+		assert.ErrorIs(t, err, retool.ErrUnsupportedByServer)
+	}
+
+	_, err = client.GetFolderOrAppAccessList(context.Background(), "app_123", retool.AppObject)
+	assert.ErrorIs(t, err, retool.ErrUnsupportedByServer)
+	assert.Contains(t, err.Error(), "requires Retool 3.96.0 (edge) or 3.114.0 (stable) or later")
+
+	_, err = client.GrantPermission(context.Background(), "group", 1, retool.ResourceObject, "resource_123", retool.UseAccess)
+	assert.ErrorIs(t, err, retool.ErrUnsupportedByServer)
+
+	_, err = client.RevokePermission(context.Background(), "group", 1, retool.ResourceObject, "resource_123")
+	assert.ErrorIs(t, err, retool.ErrUnsupportedByServer)
+
+	assert.Equal(t, int32(0), requests.Load())
+
+	_, err = client.ListGroups(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestSupports_ReleaseChannels(t *testing.T) {
+	tests := []struct {
+		version   string
+		feature   retool.Feature
+		supported bool
+	}{
+		{"3.100.0-edge", retool.FeatureAccessList, true},
+		{"3.100.0-stable", retool.FeatureAccessList, false},
+		{"3.114.2-stable", retool.FeatureAccessList, true},
+		{"3.20.0", retool.FeatureUserAttributes, false},
+		{"3.20.1", retool.FeatureUserAttributes, true},
+		{"v3.42.0", retool.FeatureConfigurationVariables, true},
+		{"3.37.0-edge", retool.FeatureResourcePermissions, true},
+		{"3.37.0-stable", retool.FeatureResourcePermissions, false},
+		{"4.0", retool.FeatureAccessList, true},
+	}
+
+	for _, tt := range tests {
+		client, err := retool.NewClient("test-api-key", "retool.example.com", retool.WithServerVersion(tt.version))
+		assert.NoError(t, err)
+
+		supported, err := client.Supports(context.Background(), tt.feature)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.supported, supported, "%s on %s", tt.feature, tt.version)
+	}
+}
+
+func TestServerInfo_ProbesOnce(t *testing.T) {
+	var probes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/checkHealth" {
+			probes.Add(1)
+			fmt.Fprintln(w, `{"status": "HEALTHY", "version": "3.19.0"}`)
+			return
+		}
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithFeatureDetection())
+	assert.NoError(t, err)
+
+	info, err := client.ServerInfo(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "3.19.0", info.Version)
+	assert.False(t, info.Cloud)
+
+	_, err = client.UpdateUserAttributes(context.Background(), "user_123", []retool.UserAttribute{{Name: "a", Value: "b"}})
+	assert.ErrorIs(t, err, retool.ErrUnsupportedByServer)
+
+	_, err = client.DeleteUserAttribute(context.Background(), "user_123", "a")
+	assert.ErrorIs(t, err, retool.ErrUnsupportedByServer)
+
+	_, err = client.ListGroupObjectPermissions(context.Background(), "group", retool.AppObject, 1)
+	assert.ErrorIs(t, err, retool.ErrUnsupportedByServer)
+
+	assert.Equal(t, int32(1), probes.Load())
+}
+
+func TestServerInfo_VersionHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Retool-Version", "3.114.2-stable")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	supported, err := client.Supports(context.Background(), retool.FeatureAccessList)
+	assert.NoError(t, err)
+	assert.True(t, supported)
+}
+
+func TestServerInfo_UnknownVersionAllowsRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/checkHealth" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "config_var_123"}]}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithFeatureDetection())
+	assert.NoError(t, err)
+
+	configVars, err := client.ListConfigurationVariables(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, configVars, 1)
+}
+
+func TestServerInfo_Cloud(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "acme.retool.com", retool.WithFeatureDetection())
+	assert.NoError(t, err)
+
+	info, err := client.ServerInfo(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, info.Cloud)
+
+	supported, err := client.Supports(context.Background(), retool.FeatureAccessList)
+	assert.NoError(t, err)
+	assert.True(t, supported)
+}
+
+func TestWithServerVersion_Invalid(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "example.com", retool.WithServerVersion("latest"))
+	assert.Nil(t, client)
+	assert.EqualError(t, err, `applying client option: invalid server version: "latest"`)
+}
+
+func TestServerInfo_FailedProbeIsNotCached(t *testing.T) {
+	var probes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes.Add(1)
+		fmt.Fprintln(w, `{"status": "HEALTHY", "version": "3.19.0"}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithFeatureDetection())
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = client.ServerInfo(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	info, err := client.ServerInfo(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "3.19.0", info.Version)
+
+	supported, err := client.Supports(context.Background(), retool.FeatureUserAttributes)
+	assert.NoError(t, err)
+	assert.False(t, supported)
+	assert.Equal(t, int32(1), probes.Load())
+}
+
+func TestServerInfo_ErrorStatusIsCachedAsUnknown(t *testing.T) {
+	var probes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/checkHealth" {
+			probes.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": []}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithFeatureDetection())
+	assert.NoError(t, err)
+
+	for range 3 {
+		_, err = client.ListConfigurationVariables(context.Background())
+		assert.NoError(t, err)
+	}
+
+	info, err := client.ServerInfo(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "", info.Version)
+	assert.Equal(t, int32(1), probes.Load())
+}
+
+func TestServerInfo_ConcurrentCallersShareProbe(t *testing.T) {
+	var probes atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes.Add(1)
+		<-release
+		fmt.Fprintln(w, `{"status": "HEALTHY", "version": "3.19.0"}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithFeatureDetection())
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			info, err := client.ServerInfo(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "3.19.0", info.Version)
+		}()
+	}
+
+	assert.Eventually(t, func() bool { return probes.Load() == 1 }, time.Second, time.Millisecond)
+
+	// A caller whose context expires stops waiting for the probe in flight.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.ServerInfo(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), probes.Load())
+}
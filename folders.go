@@ -1,9 +1,11 @@
 package retoolsdk
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"net/url"
 )
 
@@ -48,19 +50,32 @@ func (u *FolderType) Validate() error {
 }
 
 // GetFolder returns the folder with the given ID. The API token must have the "Folders > Read" scope.
-func (c *Client) GetFolder(id string) (*Folder, error) {
+func (c *Client) GetFolder(ctx context.Context, id string) (*Folder, error) {
 	baseURL := fmt.Sprintf("%s/folders/%s", c.BaseURL, id)
-	return doSingleRequest[Folder](c, "GET", baseURL, nil)
+	return doSingleRequest[Folder](ctx, c, "GET", baseURL, nil)
 }
 
 // ListFolders returns a list of folders. The API token must have the "Folders > Read" scope.
-func (c *Client) ListFolders() ([]Folder, error) {
+func (c *Client) ListFolders(ctx context.Context) ([]Folder, error) {
 	baseURL := fmt.Sprintf("%s/folders", c.BaseURL)
-	return doPaginatedRequest[Folder](c, "GET", baseURL, nil, url.Values{})
+	return doPaginatedRequest[Folder](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllFolders returns an iterator over all folders, fetching pages lazily as the iteration progresses.
+// The API token must have the "Folders > Read" scope.
+func (c *Client) AllFolders(ctx context.Context) iter.Seq2[Folder, error] {
+	return paginateItems(c.FolderPages(ctx, ""))
+}
+
+// FolderPages returns an iterator over the pages of folders, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Folders > Read" scope.
+func (c *Client) FolderPages(ctx context.Context, next string) iter.Seq2[*Page[Folder], error] {
+	baseURL := fmt.Sprintf("%s/folders", c.BaseURL)
+	return paginatePages[Folder](ctx, c, "GET", baseURL, nil, url.Values{}, next)
 }
 
 // CreateFolder creates and returns a folder. The API token must have the "Folders > Write" scope.
-func (c *Client) CreateFolder(name, parentFolderID string, folderType FolderType) (*Folder, error) {
+func (c *Client) CreateFolder(ctx context.Context, name, parentFolderID string, folderType FolderType) (*Folder, error) {
 	if name == "" {
 		return nil, errors.New("name is required")
 	}
@@ -88,11 +103,11 @@ func (c *Client) CreateFolder(name, parentFolderID string, folderType FolderType
 	}
 
 	baseURL := fmt.Sprintf("%s/folders", c.BaseURL)
-	return doSingleRequest[Folder](c, "POST", baseURL, requestBodyJSON)
+	return doSingleRequest[Folder](ctx, c, "POST", baseURL, requestBodyJSON)
 }
 
 // UpdateFolder updates a folder by ID. The API token must have the "Folders > Write" scope.
-func (c *Client) UpdateFolder(id string, operations []UpdateOperations) (*Folder, error) {
+func (c *Client) UpdateFolder(ctx context.Context, id string, operations []UpdateOperations) (*Folder, error) {
 	if len(operations) == 0 {
 		return nil, errors.New("no operations provided")
 	}
@@ -115,12 +130,12 @@ func (c *Client) UpdateFolder(id string, operations []UpdateOperations) (*Folder
 	}
 
 	baseURL := fmt.Sprintf("%s/folders/%s", c.BaseURL, id)
-	return doSingleRequest[Folder](c, "PATCH", baseURL, requestBodyJSON)
+	return doSingleRequest[Folder](ctx, c, "PATCH", baseURL, requestBodyJSON)
 }
 
 // DeleteFolder deletes a folder by ID. The API token must have the "Folders > Write" scope.
-func (c *Client) DeleteFolder(id string) error {
+func (c *Client) DeleteFolder(ctx context.Context, id string) error {
 	baseURL := fmt.Sprintf("%s/folders/%s", c.BaseURL, id)
-	_, err := doSingleRequest[any](c, "DELETE", baseURL, nil)
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
 	return err
 }
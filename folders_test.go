@@ -2,6 +2,7 @@ package retoolsdk_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	retool "github.com/thoughtgears/retoolsdk"
@@ -39,7 +40,7 @@ func TestGetFolder_Success(t *testing.T) {
 		},
 	}
 
-	folder, err := client.GetFolder("folder_123")
+	folder, err := client.GetFolder(context.Background(), "folder_123")
 
 	assert.NoError(t, err)
 	assert.NotNil(t, folder)
@@ -65,7 +66,7 @@ func TestGetFolder_Failure(t *testing.T) {
 		},
 	}
 
-	folder, err := client.GetFolder("folder_123")
+	folder, err := client.GetFolder(context.Background(), "folder_123")
 
 	assert.Error(t, err)
 	assert.Nil(t, folder)
@@ -102,7 +103,7 @@ func TestListFolders_Success(t *testing.T) {
 		},
 	}
 
-	folders, err := client.ListFolders()
+	folders, err := client.ListFolders(context.Background())
 
 	assert.NoError(t, err)
 	assert.NotNil(t, folders)
@@ -129,7 +130,7 @@ func TestListFolders_Failure(t *testing.T) {
 		},
 	}
 
-	folders, err := client.ListFolders()
+	folders, err := client.ListFolders(context.Background())
 
 	assert.Error(t, err)
 	assert.Nil(t, folders)
@@ -195,7 +196,7 @@ func TestListFolders_Pagination(t *testing.T) {
 		},
 	}
 
-	folders, err := client.ListFolders()
+	folders, err := client.ListFolders(context.Background())
 
 	assert.NoError(t, err)
 	assert.NotNil(t, folders)
@@ -232,7 +233,7 @@ func TestCreateFolder_Success(t *testing.T) {
 		},
 	}
 
-	folder, err := client.CreateFolder("Test Folder", "parent_123", retool.FolderType("app"))
+	folder, err := client.CreateFolder(context.Background(), "Test Folder", "parent_123", retool.FolderType("app"))
 
 	assert.NoError(t, err)
 	assert.NotNil(t, folder)
@@ -258,7 +259,7 @@ func TestCreateFolder_Failure(t *testing.T) {
 		},
 	}
 
-	folder, err := client.CreateFolder("Test Folder", "parent_123", retool.FolderType("invalid"))
+	folder, err := client.CreateFolder(context.Background(), "Test Folder", "parent_123", retool.FolderType("invalid"))
 
 	assert.Error(t, err)
 	assert.Nil(t, folder)
@@ -297,7 +298,7 @@ func TestUpdateFolder_Success(t *testing.T) {
 		{Op: "replace", Path: "/name", Value: "Updated Folder"},
 	}
 
-	folder, err := client.UpdateFolder("folder_123", operations)
+	folder, err := client.UpdateFolder(context.Background(), "folder_123", operations)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, folder)
@@ -327,7 +328,7 @@ func TestUpdateFolder_Failure(t *testing.T) {
 		{Op: "replace", Path: "/name", Value: "Updated Folder"},
 	}
 
-	folder, err := client.UpdateFolder("folder_123", operations)
+	folder, err := client.UpdateFolder(context.Background(), "folder_123", operations)
 
 	assert.Error(t, err)
 	assert.Nil(t, folder)
@@ -352,7 +353,7 @@ func TestDeleteFolder_Success(t *testing.T) {
 		},
 	}
 
-	err := client.DeleteFolder("folder_123")
+	err := client.DeleteFolder(context.Background(), "folder_123")
 
 	assert.NoError(t, err)
 }
@@ -376,7 +377,7 @@ func TestDeleteFolder_Failure(t *testing.T) {
 		},
 	}
 
-	err := client.DeleteFolder("folder_123")
+	err := client.DeleteFolder(context.Background(), "folder_123")
 
 	assert.Error(t, err)
 	assert.Equal(t, "Folder not found", err.Error())
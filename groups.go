@@ -1,9 +1,11 @@
 package retoolsdk
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"net/url"
 )
 
@@ -52,15 +54,28 @@ type UserInvite struct {
 }
 
 // GetGroup get a group with a given groupId. The API token must have the "Groups > Read" scope.
-func (c *Client) GetGroup(id string) (*Group, error) {
+func (c *Client) GetGroup(ctx context.Context, id string) (*Group, error) {
 	baseURL := fmt.Sprintf("%s/groups/%s", c.BaseURL, id)
-	return doSingleRequest[Group](c, "GET", baseURL, nil)
+	return doSingleRequest[Group](ctx, c, "GET", baseURL, nil)
 }
 
 // ListGroups get all permission groups for an organization or space. The API token must have the "Groups > Read" scope.
-func (c *Client) ListGroups() ([]Group, error) {
+func (c *Client) ListGroups(ctx context.Context) ([]Group, error) {
 	baseURL := fmt.Sprintf("%s/groups", c.BaseURL)
-	return doPaginatedRequest[Group](c, "GET", baseURL, nil, url.Values{})
+	return doPaginatedRequest[Group](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllGroups returns an iterator over all permission groups, fetching pages lazily as the iteration progresses.
+// The API token must have the "Groups > Read" scope.
+func (c *Client) AllGroups(ctx context.Context) iter.Seq2[Group, error] {
+	return paginateItems(c.GroupPages(ctx, ""))
+}
+
+// GroupPages returns an iterator over the pages of permission groups, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Groups > Read" scope.
+func (c *Client) GroupPages(ctx context.Context, next string) iter.Seq2[*Page[Group], error] {
+	baseURL := fmt.Sprintf("%s/groups", c.BaseURL)
+	return paginatePages[Group](ctx, c, "GET", baseURL, nil, url.Values{}, next)
 }
 
 // Validate ensures that the options provided in CreateGroupOpts have valid values.
@@ -93,18 +108,18 @@ func (g *Group) Validate() error {
 }
 
 // CreateGroup creates a group and returns the created group. The API token must have the "Groups > Write" scope.
-func (c *Client) CreateGroup(group *Group) (*Group, error) {
+func (c *Client) CreateGroup(ctx context.Context, group *Group) (*Group, error) {
 	if err := group.Validate(); err != nil {
 		return nil, err
 	}
 
 	baseURL := fmt.Sprintf("%s/groups", c.BaseURL)
-	return doSingleRequest[Group](c, "POST", baseURL, group)
+	return doSingleRequest[Group](ctx, c, "POST", baseURL, group)
 }
 
 // UpdateGroup update a group in an organization using JSON Patch (RFC 6902). Returns the updated group. The API token
 // must have the "Groups > Write" scope.
-func (c *Client) UpdateGroup(id string, operations []UpdateOperations) (*Group, error) {
+func (c *Client) UpdateGroup(ctx context.Context, id string, operations []UpdateOperations) (*Group, error) {
 	if len(operations) == 0 {
 		return nil, errors.New("no operations provided")
 	}
@@ -127,19 +142,19 @@ func (c *Client) UpdateGroup(id string, operations []UpdateOperations) (*Group,
 	}
 
 	baseURL := fmt.Sprintf("%s/groups/%s", c.BaseURL, id)
-	return doSingleRequest[Group](c, "PATCH", baseURL, requestBodyJSON)
+	return doSingleRequest[Group](ctx, c, "PATCH", baseURL, requestBodyJSON)
 }
 
 // DeleteGroup deletes a group with the given groupId. The API token must have the "Groups > Write" scope.
-func (c *Client) DeleteGroup(id string) error {
+func (c *Client) DeleteGroup(ctx context.Context, id string) error {
 	baseURL := fmt.Sprintf("%s/groups/%s", c.BaseURL, id)
-	_, err := doSingleRequest[any](c, "DELETE", baseURL, nil)
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
 	return err
 }
 
 // AddUsersToGroup adds a user to specified group and returns the group. Can optionally set or unset group admins
 // by using the is_group_admin property. The API token must have the "Groups > Write" scope.
-func (c *Client) AddUsersToGroup(groupID string, members []Member) (*Group, error) {
+func (c *Client) AddUsersToGroup(ctx context.Context, groupID string, members []Member) (*Group, error) {
 	requestBody := struct {
 		Members []Member `json:"members"`
 	}{
@@ -152,11 +167,11 @@ func (c *Client) AddUsersToGroup(groupID string, members []Member) (*Group, erro
 	}
 
 	baseURL := fmt.Sprintf("%s/groups/%s/members", c.BaseURL, groupID)
-	return doSingleRequest[Group](c, "POST", baseURL, requestBodyJSON)
+	return doSingleRequest[Group](ctx, c, "POST", baseURL, requestBodyJSON)
 }
 
 // RemoveUserFromGroup removes the user from the group and returns the group. The API token must have the "Groups > Write" scope.
-func (c *Client) RemoveUserFromGroup(groupID, userID string) (*Group, error) {
+func (c *Client) RemoveUserFromGroup(ctx context.Context, groupID, userID string) (*Group, error) {
 	baseURL := fmt.Sprintf("%s/groups/%s/members/%s", c.BaseURL, groupID, userID)
-	return doSingleRequest[Group](c, "DELETE", baseURL, nil)
+	return doSingleRequest[Group](ctx, c, "DELETE", baseURL, nil)
 }
@@ -2,6 +2,7 @@ package retoolsdk_test
 
 import (
 	"bytes"
+	"context"
 	"github.com/stretchr/testify/assert"
 	retool "github.com/thoughtgears/retoolsdk"
 	"io"
@@ -63,7 +64,7 @@ func TestGetGroup_Success(t *testing.T) {
 		},
 	}
 
-	group, err := client.GetGroup("1234")
+	group, err := client.GetGroup(context.Background(), "1234")
 	assert.NoError(t, err)
 	assert.NotNil(t, group)
 	assert.Equal(t, "Test Group", group.Name)
@@ -91,7 +92,7 @@ func TestGetGroup_Failure(t *testing.T) {
 		},
 	}
 
-	group, err := client.GetGroup("1234")
+	group, err := client.GetGroup(context.Background(), "1234")
 	assert.Error(t, err)
 	assert.Nil(t, group)
 	assert.Equal(t, "Group not found", err.Error())
@@ -144,7 +145,7 @@ func TestCreateGroup_Success(t *testing.T) {
 		},
 	}
 
-	group, err := client.CreateGroup(requestGroup)
+	group, err := client.CreateGroup(context.Background(), requestGroup)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, group)
@@ -165,7 +166,7 @@ func TestCreateGroup_ValidationFailure(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	_, err := client.CreateGroup(requestGroup)
+	_, err := client.CreateGroup(context.Background(), requestGroup)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid value for UniversalAppAccess")
 }
@@ -191,7 +192,7 @@ func TestCreateGroup_Failure(t *testing.T) {
 
 	requestGroup := &retool.Group{}
 
-	group, err := client.CreateGroup(requestGroup)
+	group, err := client.CreateGroup(context.Background(), requestGroup)
 
 	assert.Error(t, err)
 	assert.Nil(t, group)
@@ -232,7 +233,7 @@ func TestUpdateGroup_Success(t *testing.T) {
 		},
 	}
 
-	updatedGroup, err := client.UpdateGroup("123", operations)
+	updatedGroup, err := client.UpdateGroup(context.Background(), "123", operations)
 	assert.NoError(t, err)
 	assert.NotNil(t, updatedGroup)
 	assert.Equal(t, "Updated Group", updatedGroup.Name)
@@ -266,7 +267,7 @@ func TestUpdateGroup_Failure(t *testing.T) {
 		},
 	}
 
-	updatedGroup, err := client.UpdateGroup("123", operations)
+	updatedGroup, err := client.UpdateGroup(context.Background(), "123", operations)
 	assert.Error(t, err)
 	assert.Nil(t, updatedGroup)
 	assert.Equal(t, "validation failed for operation: value cannot be empty for replace operation", err.Error())
@@ -285,7 +286,7 @@ func TestDeleteGroup_Success(t *testing.T) {
 		},
 	}
 
-	err := client.DeleteGroup("1234")
+	err := client.DeleteGroup(context.Background(), "1234")
 	assert.NoError(t, err)
 }
 
@@ -308,7 +309,7 @@ func TestDeleteGroup_Failure(t *testing.T) {
 		},
 	}
 
-	err := client.DeleteGroup("123")
+	err := client.DeleteGroup(context.Background(), "123")
 	assert.Error(t, err)
 	assert.Equal(t, "No group '123' found for organization", err.Error())
 }
@@ -333,7 +334,7 @@ func TestAddUsersToGroup_Success(t *testing.T) {
 		},
 	}
 
-	group, err := client.AddUsersToGroup("123", members)
+	group, err := client.AddUsersToGroup(context.Background(), "123", members)
 	assert.NoError(t, err)
 	assert.NotNil(t, group)
 	assert.Equal(t, "Test Group", group.Name)
@@ -358,7 +359,7 @@ func TestAddUsersToGroup_Failure(t *testing.T) {
 		},
 	}
 
-	group, err := client.AddUsersToGroup("123", []retool.Member{})
+	group, err := client.AddUsersToGroup(context.Background(), "123", []retool.Member{})
 	assert.Error(t, err)
 	assert.Nil(t, group)
 	assert.Equal(t, "Group not found", err.Error())
@@ -377,7 +378,7 @@ func TestRemoveUserFromGroup_Success(t *testing.T) {
 		},
 	}
 
-	group, err := client.RemoveUserFromGroup("123", "user_123")
+	group, err := client.RemoveUserFromGroup(context.Background(), "123", "user_123")
 	assert.NoError(t, err)
 	assert.NotNil(t, group)
 	assert.Equal(t, "Test Group", group.Name)
@@ -402,7 +403,7 @@ func TestRemoveUserFromGroup_Failure(t *testing.T) {
 		},
 	}
 
-	group, err := client.RemoveUserFromGroup("123", "user_123")
+	group, err := client.RemoveUserFromGroup(context.Background(), "123", "user_123")
 	assert.Error(t, err)
 	assert.Nil(t, group)
 	assert.Equal(t, "Group not found", err.Error())
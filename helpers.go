@@ -1,37 +1,62 @@
 package retoolsdk
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
+	"slices"
 )
 
-// decodeResponse is a helper function to decode JSON responses.
+// decodeResponse is a helper function to decode JSON responses. Error status codes and unsuccessful responses
+// are returned as *APIError, even when the body is not JSON.
 func decodeResponse[T any](resp *http.Response) (*Response[T], error) {
+	var body []byte
+	if resp.Body != nil {
+		defer resp.Body.Close()
+
+		var err error
+		body, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading response: %w", err)
+		}
+	}
+
 	var response Response[T]
+	decodeErr := json.Unmarshal(body, &response)
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, newAPIError(resp, body, response.Message)
+	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("decoding response: %w", err)
+	if decodeErr != nil {
+		return nil, fmt.Errorf("decoding response: %w", decodeErr)
 	}
 
 	if !response.Success {
-		return nil, errors.New(response.Message)
+		return nil, newAPIError(resp, body, response.Message)
 	}
 
 	return &response, nil
 }
 
 // doSingleRequest is a helper function for making single resource requests.
-func doSingleRequest[T any](client *Client, method, url string, body interface{}) (*T, error) {
-	resp, err := client.Do(method, url, body)
+func doSingleRequest[T any](ctx context.Context, client *Client, method, url string, body interface{}) (*T, error) {
+	resp, err := client.Do(ctx, method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("making request: %w", err)
 	}
 
 	// Check if the response is empty
 	if resp.StatusCode == http.StatusNoContent {
+		discardResponse(resp)
 		return nil, nil
 	}
 
@@ -44,31 +69,58 @@ func doSingleRequest[T any](client *Client, method, url string, body interface{}
 }
 
 // doPaginatedRequest is a helper function to make paginated requests to the API.
-func doPaginatedRequest[T any](client *Client, method, baseURL string, body []byte, query url.Values) ([]T, error) {
+// The context is checked before every page, so a cancelled listing stops without fetching the remaining pages.
+func doPaginatedRequest[T any](ctx context.Context, client *Client, method, baseURL string, body []byte, query url.Values) ([]T, error) {
 	var allItems []T
-	var nextToken string
-	hasMore := true
 
-	for hasMore {
-		if nextToken != "" {
-			query.Set("next", nextToken)
+	for item, err := range paginateItems(paginatePages[T](ctx, client, method, baseURL, body, query, "")) {
+		if err != nil {
+			return nil, err
 		}
+		allItems = append(allItems, item)
+	}
 
-		urlWithQuery := fmt.Sprintf("%s?%s", baseURL, query.Encode())
-		resp, err := client.Do(method, urlWithQuery, body)
-		if err != nil {
-			return nil, fmt.Errorf("making request: %w", err)
+	return allItems, nil
+}
+
+// multipartFile is a file part of a multipart request body.
+type multipartFile struct {
+	field       string
+	filename    string
+	contentType string
+	data        []byte
+}
+
+// newMultipartBody is a helper function to encode form fields and files as a multipart/form-data request body.
+// The fields are written in sorted order, followed by the files.
+func newMultipartBody(fields map[string]string, files ...multipartFile) (RawBody, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		if err := writer.WriteField(name, fields[name]); err != nil {
+			return RawBody{}, fmt.Errorf("writing field %s: %w", name, err)
 		}
+	}
 
-		responseData, err := decodeResponse[[]T](resp)
+	for _, file := range files {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{"name": file.field, "filename": file.filename}))
+		header.Set("Content-Type", file.contentType)
+
+		part, err := writer.CreatePart(header)
 		if err != nil {
-			return nil, err
+			return RawBody{}, fmt.Errorf("creating part %s: %w", file.field, err)
+		}
+
+		if _, err := part.Write(file.data); err != nil {
+			return RawBody{}, fmt.Errorf("writing part %s: %w", file.field, err)
 		}
+	}
 
-		allItems = append(allItems, responseData.Data...)
-		nextToken = responseData.NextToken
-		hasMore = responseData.HasMore
+	if err := writer.Close(); err != nil {
+		return RawBody{}, fmt.Errorf("closing multipart body: %w", err)
 	}
 
-	return allItems, nil
+	return RawBody{ContentType: writer.FormDataContentType(), Data: buf.Bytes()}, nil
 }
@@ -0,0 +1,47 @@
+// Package apierror describes failed responses of the Retool API for the packages of the SDK, so that the errors of
+// the root package and of scim report the same request details.
+package apierror
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxBodySize is the maximum number of bytes of the response body kept in Details.Body.
+const maxBodySize = 1024
+
+// Details identifies a failed request and holds a snippet of the response body.
+type Details struct {
+	Method    string
+	URL       string
+	RequestID string
+	Body      string
+}
+
+// Describe returns the details of the response and its already read body.
+func Describe(resp *http.Response, body []byte) Details {
+	details := Details{
+		RequestID: resp.Header.Get("X-Request-Id"),
+	}
+
+	if resp.Request != nil {
+		details.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			details.URL = resp.Request.URL.String()
+		}
+	}
+
+	snippet := strings.TrimSpace(string(body))
+	if len(snippet) > maxBodySize {
+		// Back off to the start of a rune, so that a multi-byte character is not split.
+		cut := maxBodySize
+		for cut > 0 && !utf8.RuneStart(snippet[cut]) {
+			cut--
+		}
+		snippet = snippet[:cut] + "..."
+	}
+	details.Body = snippet
+
+	return details
+}
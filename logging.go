@@ -0,0 +1,276 @@
+package retoolsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// redacted replaces secret values in logged headers and bodies.
+const redacted = "[REDACTED]"
+
+// maxLoggedBodySize is the maximum number of bytes of a request or response body written to the log.
+const maxLoggedBodySize = 4096
+
+// WithLogger logs every request made by the client with the given logger. Successful requests are logged
+// at debug level, failed attempts at warn level, with the method, path, status, latency, page token and retry count.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		if logger == nil {
+			return errors.New("logger cannot be nil")
+		}
+
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithBodyLogging adds the request headers and the request and response bodies to the log records written by
+// WithLogger. The Authorization header, the values of secret configuration variables, user attribute values,
+// resource and source control credentials, SSO client secrets, signed embed URLs and user invite links are
+// redacted.
+func WithBodyLogging() ClientOption {
+	return func(c *Client) error {
+		c.logBodies = true
+		return nil
+	}
+}
+
+// logRequest logs a single request attempt. When body logging is enabled the response body is read and
+// replaced, so the returned response must be used instead of resp.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, requestBody []byte, resp *http.Response, err error, retry int, latency time.Duration) *http.Response {
+	if c.logger == nil {
+		return resp
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("latency", latency),
+		slog.Int("retry", retry),
+	}
+
+	if next := req.URL.Query().Get("next"); next != "" {
+		attrs = append(attrs, slog.String("page_token", next))
+	}
+
+	level := slog.LevelDebug
+
+	if err != nil {
+		level = slog.LevelWarn
+		attrs = append(attrs, slog.String("error", err.Error()))
+	} else {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		if resp.StatusCode >= http.StatusBadRequest {
+			level = slog.LevelWarn
+		}
+	}
+
+	if c.logBodies {
+		attrs = append(attrs, slog.Any("request_headers", redactHeaders(req.Header)))
+		if len(requestBody) > 0 {
+			attrs = append(attrs, slog.String("request_body", redactRequestBody(req, requestBody)))
+		}
+
+		if resp != nil && resp.Body != nil {
+			responseBody, readErr := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+			if readErr != nil {
+				attrs = append(attrs, slog.String("response_body_error", readErr.Error()))
+			} else if len(responseBody) > 0 {
+				attrs = append(attrs, slog.String("response_body", redactBody(req.URL.Path, responseBody)))
+			}
+		}
+	}
+
+	c.logger.LogAttrs(ctx, level, "retool request", attrs...)
+
+	return resp
+}
+
+// redactHeaders returns a copy of the headers with credentials replaced.
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+
+	for name, values := range header {
+		switch http.CanonicalHeaderKey(name) {
+		case "Authorization", "Cookie", "Set-Cookie":
+			headers[name] = redacted
+		default:
+			headers[name] = strings.Join(values, ", ")
+		}
+	}
+
+	return headers
+}
+
+// redactRequestBody returns the request body for logging. Bodies that are not JSON, such as file uploads, are
+// replaced by their size and media type.
+func redactRequestBody(req *http.Request, body []byte) string {
+	contentType := req.Header.Get("Content-Type")
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && !isJSONMediaType(mediaType) {
+		return fmt.Sprintf("[%d bytes of %s]", len(body), mediaType)
+	}
+
+	return redactBody(req.URL.Path, body)
+}
+
+// isJSONMediaType reports whether the media type is JSON, including structured syntax types such as
+// application/scim+json.
+func isJSONMediaType(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// redactBody returns the body for logging with secret values replaced. Bodies that are not JSON are logged as is.
+func redactBody(path string, body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return truncateBody(string(body))
+	}
+
+	value = redactValue(value, strings.Contains(path, "/user_attributes"))
+
+	redactedBody, err := json.Marshal(value)
+	if err != nil {
+		return redacted
+	}
+
+	return truncateBody(string(redactedBody))
+}
+
+// redactValue walks a decoded JSON value and replaces the values of secret configuration variables, user
+// metadata (where user attribute values are stored), secret resource options, source control and observability
+// credentials, SSO client secrets, signed embed URLs and, on user attribute endpoints, attribute values.
+func redactValue(value interface{}, userAttributes bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			switch {
+			case key == "metadata":
+				v[key] = redactMetadata(field)
+			case key == "options" || key == "config":
+				v[key] = redactOptions(field, userAttributes)
+			case isSecretField(key):
+				v[key] = redacted
+			case key == "value" && userAttributes:
+				v[key] = redacted
+			default:
+				v[key] = redactValue(field, userAttributes)
+			}
+		}
+
+		if secret, ok := v["secret"].(bool); ok && secret {
+			if values, ok := v["values"].([]interface{}); ok {
+				for _, item := range values {
+					if entry, ok := item.(map[string]interface{}); ok {
+						if _, ok := entry["value"]; ok {
+							entry["value"] = redacted
+						}
+					}
+				}
+			}
+		}
+
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item, userAttributes)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// redactMetadata replaces every value of a user metadata object, keeping the attribute names.
+func redactMetadata(value interface{}) interface{} {
+	metadata, ok := value.(map[string]interface{})
+	if !ok {
+		if value == nil {
+			return nil
+		}
+		return redacted
+	}
+
+	for key := range metadata {
+		metadata[key] = redacted
+	}
+
+	return metadata
+}
+
+// secretOptionFields are the resource option, source control and observability configuration fields holding
+// credentials.
+var secretOptionFields = map[string]struct{}{
+	"database_password":     {},
+	"basic_auth_password":   {},
+	"bearer_token":          {},
+	"secret_access_key":     {},
+	"private_key":           {},
+	"personal_access_token": {},
+	"project_access_token":  {},
+	"app_password":          {},
+	"https_password":        {},
+	"api_key":               {},
+	"dsn":                   {},
+}
+
+// secretFields are the fields holding credentials wherever they appear in a body.
+var secretFields = map[string]struct{}{
+	"oidc_client_secret":   {},
+	"google_client_secret": {},
+	"embedUrl":             {},
+	"invite_link":          {},
+}
+
+// isSecretField reports whether the field holds credentials outside of resource options.
+func isSecretField(key string) bool {
+	_, ok := secretFields[key]
+	return ok
+}
+
+// redactOptions replaces the credentials and header values of resource options and of source control and
+// observability configurations.
+func redactOptions(value interface{}, userAttributes bool) interface{} {
+	options, ok := value.(map[string]interface{})
+	if !ok {
+		return redactValue(value, userAttributes)
+	}
+
+	for key, field := range options {
+		if _, ok := secretOptionFields[key]; ok {
+			options[key] = redacted
+			continue
+		}
+
+		if headers, ok := field.(map[string]interface{}); ok && key == "headers" {
+			for name := range headers {
+				headers[name] = redacted
+			}
+			continue
+		}
+
+		options[key] = redactValue(field, userAttributes)
+	}
+
+	return options
+}
+
+// truncateBody shortens a body to maxLoggedBodySize bytes.
+func truncateBody(body string) string {
+	if len(body) > maxLoggedBodySize {
+		return body[:maxLoggedBodySize] + "..."
+	}
+
+	return body
+}
@@ -0,0 +1,349 @@
+package retoolsdk_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// decodeLogRecords decodes the JSON log records written to buf.
+func decodeLogRecords(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var records []map[string]interface{}
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func TestWithLogger_LogsRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("next") == "page2" {
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": 2, "name": "Viewers"}], "has_more": false}`)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": 1, "name": "Admins"}], "next_token": "page2", "has_more": true}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger))
+	assert.NoError(t, err)
+
+	groups, err := client.ListGroups(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, groups, 2)
+
+	records := decodeLogRecords(t, &buf)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "retool request", records[0]["msg"])
+	assert.Equal(t, "DEBUG", records[0]["level"])
+	assert.Equal(t, "GET", records[0]["method"])
+	assert.Equal(t, "/api/v2/groups", records[0]["path"])
+	assert.Equal(t, float64(200), records[0]["status"])
+	assert.Equal(t, float64(0), records[0]["retry"])
+	assert.Contains(t, records[0], "latency")
+	assert.NotContains(t, records[0], "page_token")
+	assert.NotContains(t, records[0], "response_body")
+	assert.Equal(t, "page2", records[1]["page_token"])
+}
+
+func TestWithLogger_LogsRetries(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "user_123"}}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger),
+		retool.WithRetryPolicy(retool.RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}))
+	assert.NoError(t, err)
+
+	_, err = client.GetUser(context.Background(), "user_123")
+	assert.NoError(t, err)
+
+	records := decodeLogRecords(t, &buf)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "WARN", records[0]["level"])
+	assert.Equal(t, float64(503), records[0]["status"])
+	assert.Equal(t, float64(0), records[0]["retry"])
+	assert.Equal(t, "DEBUG", records[1]["level"])
+	assert.Equal(t, float64(1), records[1]["retry"])
+}
+
+func TestWithBodyLogging_RedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "config_var_123", "name": "API_TOKEN", "secret": true, "values": [{"environment_id": "production", "value": "super-secret"}]}}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger), retool.WithBodyLogging())
+	assert.NoError(t, err)
+
+	configVar, err := client.CreateConfigurationVariable(context.Background(), "API_TOKEN", "", true,
+		[]retool.Value{{EnvironmentId: "production", Value: "super-secret"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "super-secret", configVar.Values[0].Value)
+
+	output := buf.String()
+	assert.NotContains(t, output, "super-secret")
+	assert.NotContains(t, output, "test-api-key")
+
+	records := decodeLogRecords(t, &buf)
+	assert.Len(t, records, 1)
+	assert.Contains(t, records[0]["response_body"], "[REDACTED]")
+	assert.Contains(t, records[0]["response_body"], "API_TOKEN")
+
+	headers, ok := records[0]["request_headers"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, "[REDACTED]", headers["Authorization"])
+}
+
+func TestWithBodyLogging_RedactsUserAttributes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"metadata": {"department": "finance-secret"}}}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger), retool.WithBodyLogging())
+	assert.NoError(t, err)
+
+	metadata, err := client.UpdateUserAttributes(context.Background(), "user_123",
+		[]retool.UserAttribute{{Name: "department", Value: "finance-secret"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "finance-secret", metadata["department"])
+
+	output := buf.String()
+	assert.NotContains(t, output, "finance-secret")
+	assert.Contains(t, output, "department")
+}
+
+func TestWithBodyLogging_RedactsResourceOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "resource_123", "type": "restapi", "options": {"base_url": "https://api.example.com", "bearer_token": "token-secret", "headers": {"X-Api-Key": "header-secret"}}}}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger), retool.WithBodyLogging())
+	assert.NoError(t, err)
+
+	_, err = client.CreateResource(context.Background(), "Payments API", "", retool.RESTAPIOptions{
+		BaseURL:        "https://api.example.com",
+		Headers:        map[string]string{"X-Api-Key": "header-secret"},
+		Authentication: retool.AuthenticationBearer,
+		BearerToken:    "token-secret",
+	})
+	assert.NoError(t, err)
+
+	output := buf.String()
+	assert.NotContains(t, output, "token-secret")
+	assert.NotContains(t, output, "header-secret")
+	assert.Contains(t, output, "X-Api-Key")
+	assert.Contains(t, output, "https://api.example.com")
+}
+
+func TestWithLogger_Nil(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "example.com", retool.WithLogger(nil))
+	assert.Nil(t, client)
+	assert.EqualError(t, err, "applying client option: logger cannot be nil")
+}
+
+func TestWithBodyLogging_RedactsSourceControlCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"provider": "GitHub", "org": "acme", "repo": "retool", "default_branch": "main", "config": {"type": "Personal", "personal_access_token": "token-secret"}}}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger), retool.WithBodyLogging())
+	assert.NoError(t, err)
+
+	_, err = client.UpdateSourceControlConfig(context.Background(), &retool.SourceControlConfig{
+		Provider:      retool.SourceControlGitHub,
+		Org:           "acme",
+		Repo:          "retool",
+		DefaultBranch: "main",
+		Config:        retool.SourceControlCredentials{Type: retool.GitHubPersonal, PersonalAccessToken: "token-secret"},
+	})
+	assert.NoError(t, err)
+
+	output := buf.String()
+	assert.NotContains(t, output, "token-secret")
+	assert.Contains(t, output, "personal_access_token")
+}
+
+func TestWithBodyLogging_RedactsSSOClientSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"config_type": "google", "google_client_id": "retool", "google_client_secret": "google-secret"}}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger), retool.WithBodyLogging())
+	assert.NoError(t, err)
+
+	_, err = client.SetSSOConfig(context.Background(), retool.GoogleSSOConfig{ClientID: "retool", ClientSecret: "google-secret"})
+	assert.NoError(t, err)
+
+	output := buf.String()
+	assert.NotContains(t, output, "google-secret")
+	assert.Contains(t, output, "google_client_secret")
+}
+
+func TestWithBodyLogging_RedactsEmbedURLs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"embedUrl": "https://retool.example.com/embedded/authed/signed-token"}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger), retool.WithBodyLogging())
+	assert.NoError(t, err)
+
+	_, err = client.CreateEmbedURL(context.Background(), &retool.EmbedURLRequest{
+		LandingPageUUID:    "8f2c7c3e-5a8b-11ee-8c99-0242ac120002",
+		ExternalIdentifier: "customer-42",
+		GroupIDs:           []int{7},
+	})
+	assert.NoError(t, err)
+
+	assert.NotContains(t, buf.String(), "signed-token")
+}
+
+func TestWithBodyLogging_RedactsInviteLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"id": 42, "invited_email": "john@example.com", "invite_link": "https://retool.example.com/invite/abc"}}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger), retool.WithBodyLogging())
+	assert.NoError(t, err)
+
+	_, err = client.GetUserInvite(context.Background(), "42")
+	assert.NoError(t, err)
+
+	output := buf.String()
+	assert.NotContains(t, output, "invite/abc")
+	assert.Contains(t, output, "john@example.com")
+}
+
+func TestWithBodyLogging_SummarizesUploads(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "rev_1", "library_id": "lib_1", "version": 1}}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger), retool.WithBodyLogging())
+	assert.NoError(t, err)
+
+	_, err = client.UploadLibraryRevision(context.Background(), "lib_1", bytes.NewReader([]byte{0x1f, 0x8b, 0x08, 0x00}))
+	assert.NoError(t, err)
+
+	records := decodeLogRecords(t, &buf)
+	if assert.Len(t, records, 1) {
+		assert.Regexp(t, `^\[\d+ bytes of multipart/form-data\]$`, records[0]["request_body"])
+	}
+}
+
+func TestWithBodyLogging_RedactsObservabilityCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "obs_1", "provider": "otlp", "enabled": true, "config": {"endpoint": "https://otel.example.com", "headers": {"x-api-key": "otlp-secret"}}}}`)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger), retool.WithBodyLogging())
+	assert.NoError(t, err)
+
+	_, err = client.CreateObservabilityConfig(context.Background(), &retool.ObservabilityConfig{
+		Provider: retool.ObservabilityOTLP,
+		Enabled:  true,
+		Config:   retool.ObservabilityProviderConfig{Endpoint: "https://otel.example.com", Headers: map[string]string{"x-api-key": "otlp-secret"}},
+	})
+	assert.NoError(t, err)
+
+	_, err = client.TestObservabilityConnection(context.Background(), &retool.ObservabilityConfig{
+		Provider: retool.ObservabilitySentry,
+		Config:   retool.ObservabilityProviderConfig{DSN: "https://sentry-key@o1.ingest.sentry.io/42"},
+	})
+	assert.NoError(t, err)
+
+	output := buf.String()
+	assert.NotContains(t, output, "otlp-secret")
+	assert.NotContains(t, output, "sentry-key")
+	assert.Contains(t, output, "x-api-key")
+}
+
+func TestWithBodyLogging_LogsStructuredJSONBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithLogger(logger), retool.WithBodyLogging())
+	assert.NoError(t, err)
+
+	_, err = client.Do(context.Background(), "POST", server.URL+"/api/scim/v2/Users", retool.RawBody{
+		ContentType: "application/scim+json",
+		Data:        []byte(`{"userName": "jane@example.com"}`),
+	})
+	assert.NoError(t, err)
+
+	records := decodeLogRecords(t, &buf)
+	if assert.Len(t, records, 1) {
+		assert.JSONEq(t, `{"userName": "jane@example.com"}`, records[0]["request_body"].(string))
+	}
+}
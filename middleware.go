@@ -0,0 +1,46 @@
+package retoolsdk
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Middleware wraps the RoundTripper used to send requests to the Retool API. It can add headers, record
+// metrics or traces, or audit requests before calling next.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc is an adapter to allow the use of ordinary functions as http.RoundTripper.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// WithMiddleware adds middlewares to every request made by the client. Middlewares are applied in order, the
+// first one being the outermost, and all of them run before the built-in transport that adds the API key and
+// applies the rate limit. The option can be passed multiple times.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *Client) error {
+		for _, middleware := range middlewares {
+			if middleware == nil {
+				return errors.New("middleware cannot be nil")
+			}
+		}
+
+		c.middlewares = append(c.middlewares, middlewares...)
+		return nil
+	}
+}
+
+// chainMiddlewares wraps the transport with the middlewares, the first middleware being the outermost.
+func chainMiddlewares(transport http.RoundTripper, middlewares []Middleware) (http.RoundTripper, error) {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		transport = middlewares[i](transport)
+		if transport == nil {
+			return nil, errors.New("middleware returned a nil RoundTripper")
+		}
+	}
+
+	return transport, nil
+}
@@ -0,0 +1,106 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithMiddleware_Order(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+		assert.Equal(t, []string{"first", "second"}, r.Header.Values("X-Middleware"))
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "user_123"}}`)
+	}))
+	defer server.Close()
+
+	var calls []string
+	var mu sync.Mutex
+
+	middleware := func(name string) retool.Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return retool.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				mu.Lock()
+				calls = append(calls, name)
+				mu.Unlock()
+
+				// The built-in auth transport runs after the middlewares.
+				assert.Empty(t, req.Header.Get("Authorization"))
+
+				req.Header.Add("X-Middleware", name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	client, err := retool.NewClient("test-api-key", server.URL,
+		retool.WithMiddleware(middleware("first")),
+		retool.WithMiddleware(middleware("second")))
+	assert.NoError(t, err)
+
+	user, err := client.GetUser(context.Background(), "user_123")
+	assert.NoError(t, err)
+	assert.Equal(t, "user_123", user.ID)
+	assert.Equal(t, []string{"first", "second"}, calls)
+}
+
+func TestWithMiddleware_UserAttributes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "audit", r.Header.Get("X-Audit"))
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "user_123", "metadata": {"department": "finance"}}}`)
+	}))
+	defer server.Close()
+
+	var paths []string
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+		return retool.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			paths = append(paths, req.Method+" "+req.URL.Path)
+			req.Header.Set("X-Audit", "audit")
+			return next.RoundTrip(req)
+		})
+	}))
+	assert.NoError(t, err)
+
+	_, err = client.UpdateUserAttributes(context.Background(), "user_123", []retool.UserAttribute{{Name: "department", Value: "finance"}})
+	assert.NoError(t, err)
+
+	_, err = client.DeleteUserAttribute(context.Background(), "user_123", "department")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"POST /api/v2/users/user_123/user_attributes",
+		"DELETE /api/v2/users/user_123/user_attributes/department",
+	}, paths)
+}
+
+func TestWithMiddleware_ShortCircuit(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "example.invalid", retool.WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+		return retool.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("blocked %s", req.URL.Path)
+		})
+	}))
+	assert.NoError(t, err)
+
+	_, err = client.GetUser(context.Background(), "user_123")
+	assert.ErrorContains(t, err, "blocked /api/v2/users/user_123")
+}
+
+func TestWithMiddleware_Invalid(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "example.com", retool.WithMiddleware(nil))
+	assert.Nil(t, client)
+	assert.EqualError(t, err, "applying client option: middleware cannot be nil")
+
+	client, err = retool.NewClient("test-api-key", "example.com", retool.WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+		return nil
+	}))
+	assert.Nil(t, client)
+	assert.EqualError(t, err, "applying client option: middleware returned a nil RoundTripper")
+}
@@ -0,0 +1,217 @@
+package retoolsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"strings"
+)
+
+// Observability providers.
+const (
+	ObservabilityDatadog = "datadog"
+	ObservabilitySentry  = "sentry"
+	ObservabilityOTLP    = "otlp"
+)
+
+// OTLP protocols of ObservabilityProviderConfig.
+const (
+	OTLPProtocolGRPC = "grpc"
+	OTLPProtocolHTTP = "http/protobuf"
+)
+
+// ObservabilityConfig is an integration sending the logs, traces or errors of a space to an observability provider.
+type ObservabilityConfig struct {
+	ID        string                      `json:"id,omitempty"`
+	Provider  string                      `json:"provider"`
+	Enabled   bool                        `json:"enabled"`
+	Config    ObservabilityProviderConfig `json:"config"`
+	CreatedAt string                      `json:"created_at,omitempty"`
+	UpdatedAt string                      `json:"updated_at,omitempty"`
+}
+
+// Validate checks that the settings required by the provider are set and valid.
+func (c *ObservabilityConfig) Validate() error {
+	return c.Config.validate(c.Provider)
+}
+
+// ObservabilityProviderConfig holds the provider-specific settings of an ObservabilityConfig. Only the fields of the
+// configured provider are used. The API key, the DSN and the header values are redacted when the settings are
+// formatted.
+type ObservabilityProviderConfig struct {
+	// Site is the Datadog site, such as "datadoghq.eu". The server default is used when it is empty.
+	Site   string `json:"site,omitempty"`
+	APIKey string `json:"api_key,omitempty"`
+
+	// DSN is the Sentry client key URL, which includes the public key of the project.
+	DSN         string `json:"dsn,omitempty"`
+	Environment string `json:"environment,omitempty"`
+	// SampleRate is the share of errors sent to Sentry, between 0 and 1. The server default is used when it is 0.
+	SampleRate float64 `json:"sample_rate,omitempty"`
+
+	// Endpoint is the URL of the OTLP collector.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Protocol is the OTLP protocol, OTLPProtocolGRPC (the default) or OTLPProtocolHTTP.
+	Protocol string `json:"protocol,omitempty"`
+	// Headers are sent with every export to the collector, typically to authenticate.
+	Headers     map[string]string `json:"headers,omitempty"`
+	ServiceName string            `json:"service_name,omitempty"`
+}
+
+// validate checks that the settings required by the provider are set and valid.
+func (c ObservabilityProviderConfig) validate(provider string) error {
+	switch provider {
+	case ObservabilityDatadog:
+		if c.APIKey == "" {
+			return fmt.Errorf("api key is required for %s", provider)
+		}
+	case ObservabilitySentry:
+		if c.DSN == "" {
+			return fmt.Errorf("dsn is required for %s", provider)
+		}
+
+		dsn, err := url.Parse(c.DSN)
+		if err != nil || dsn.Scheme == "" || dsn.Host == "" || dsn.User.Username() == "" || strings.Trim(dsn.Path, "/") == "" {
+			// The DSN is a secret, so it is left out of the error.
+			return errors.New("invalid dsn: expected https://<public key>@<host>/<project id>")
+		}
+
+		if c.SampleRate < 0 || c.SampleRate > 1 {
+			return fmt.Errorf("invalid sample rate: %g", c.SampleRate)
+		}
+	case ObservabilityOTLP:
+		if c.Endpoint == "" {
+			return fmt.Errorf("endpoint is required for %s", provider)
+		}
+
+		endpoint, err := url.Parse(c.Endpoint)
+		if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+			return fmt.Errorf("invalid endpoint: %s", c.Endpoint)
+		}
+
+		switch c.Protocol {
+		case "", OTLPProtocolGRPC, OTLPProtocolHTTP:
+		default:
+			return fmt.Errorf("invalid otlp protocol: %s", c.Protocol)
+		}
+	default:
+		return fmt.Errorf("invalid observability provider: %s", provider)
+	}
+
+	return nil
+}
+
+// String formats the settings with the API key, the DSN and the header values redacted.
+func (c ObservabilityProviderConfig) String() string {
+	return fmt.Sprintf("ObservabilityProviderConfig{Site:%s APIKey:%s DSN:%s Environment:%s SampleRate:%g Endpoint:%s "+
+		"Protocol:%s Headers:%s ServiceName:%s}",
+		c.Site, secret(c.APIKey), secret(c.DSN), c.Environment, c.SampleRate, c.Endpoint,
+		c.Protocol, formatHeaders(c.Headers), c.ServiceName)
+}
+
+// GoString formats the settings for %#v with the API key, the DSN and the header values redacted.
+func (c ObservabilityProviderConfig) GoString() string { return c.String() }
+
+// observabilityConfigRequest validates the configuration and returns the request body for it, leaving out the
+// fields set by the server.
+func observabilityConfigRequest(config *ObservabilityConfig) ([]byte, error) {
+	if config == nil {
+		return nil, errors.New("observability config cannot be nil")
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("validating observability config: %w", err)
+	}
+
+	requestBody := struct {
+		Provider string                      `json:"provider"`
+		Enabled  bool                        `json:"enabled"`
+		Config   ObservabilityProviderConfig `json:"config"`
+	}{
+		Provider: config.Provider,
+		Enabled:  config.Enabled,
+		Config:   config.Config,
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	return requestBodyJSON, nil
+}
+
+// GetObservabilityConfig returns the observability configuration with the given ID.
+// The API token must have the "Observability > Read" scope.
+func (c *Client) GetObservabilityConfig(ctx context.Context, id string) (*ObservabilityConfig, error) {
+	baseURL := fmt.Sprintf("%s/observability/configs/%s", c.BaseURL, id)
+	return doSingleRequest[ObservabilityConfig](ctx, c, "GET", baseURL, nil)
+}
+
+// ListObservabilityConfigs returns the observability configurations of the space.
+// The API token must have the "Observability > Read" scope.
+func (c *Client) ListObservabilityConfigs(ctx context.Context) ([]ObservabilityConfig, error) {
+	baseURL := fmt.Sprintf("%s/observability/configs", c.BaseURL)
+	return doPaginatedRequest[ObservabilityConfig](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllObservabilityConfigs returns an iterator over all observability configurations, fetching pages lazily as the
+// iteration progresses. The API token must have the "Observability > Read" scope.
+func (c *Client) AllObservabilityConfigs(ctx context.Context) iter.Seq2[ObservabilityConfig, error] {
+	return paginateItems(c.ObservabilityConfigPages(ctx, ""))
+}
+
+// ObservabilityConfigPages returns an iterator over the pages of observability configurations, starting at the page
+// identified by next (or the first page when empty). The API token must have the "Observability > Read" scope.
+func (c *Client) ObservabilityConfigPages(ctx context.Context, next string) iter.Seq2[*Page[ObservabilityConfig], error] {
+	baseURL := fmt.Sprintf("%s/observability/configs", c.BaseURL)
+	return paginatePages[ObservabilityConfig](ctx, c, "GET", baseURL, nil, url.Values{}, next)
+}
+
+// CreateObservabilityConfig creates an observability configuration and returns it. The configuration is validated
+// before it is sent. The API token must have the "Observability > Write" scope.
+func (c *Client) CreateObservabilityConfig(ctx context.Context, config *ObservabilityConfig) (*ObservabilityConfig, error) {
+	requestBodyJSON, err := observabilityConfigRequest(config)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/observability/configs", c.BaseURL)
+	return doSingleRequest[ObservabilityConfig](ctx, c, "POST", baseURL, requestBodyJSON)
+}
+
+// UpdateObservabilityConfig replaces the observability configuration with the given ID and returns it. The
+// configuration is validated before it is sent. The API token must have the "Observability > Write" scope.
+func (c *Client) UpdateObservabilityConfig(ctx context.Context, id string, config *ObservabilityConfig) (*ObservabilityConfig, error) {
+	requestBodyJSON, err := observabilityConfigRequest(config)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/observability/configs/%s", c.BaseURL, id)
+	return doSingleRequest[ObservabilityConfig](ctx, c, "PUT", baseURL, requestBodyJSON)
+}
+
+// DeleteObservabilityConfig deletes the observability configuration with the given ID.
+// The API token must have the "Observability > Write" scope.
+func (c *Client) DeleteObservabilityConfig(ctx context.Context, id string) error {
+	baseURL := fmt.Sprintf("%s/observability/configs/%s", c.BaseURL, id)
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
+	return err
+}
+
+// TestObservabilityConnection checks that Retool can send data to the provider with the configuration, before it is
+// saved. A failed connection is reported in the result, not as an error.
+// The API token must have the "Observability > Write" scope.
+func (c *Client) TestObservabilityConnection(ctx context.Context, config *ObservabilityConfig) (*ConnectionTestResult, error) {
+	requestBodyJSON, err := observabilityConfigRequest(config)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/observability/test_connection", c.BaseURL)
+	return doSingleRequest[ConnectionTestResult](ctx, c, "POST", baseURL, requestBodyJSON)
+}
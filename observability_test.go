@@ -0,0 +1,130 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateObservabilityConfig_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/observability/configs", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"provider": "datadog", "enabled": true, "config": {"site": "datadoghq.eu", "api_key": "dd-key"}}`, string(body))
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "obs_1", "provider": "datadog", "enabled": true, "config": {"site": "datadoghq.eu"}}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	config, err := client.CreateObservabilityConfig(context.Background(), &retool.ObservabilityConfig{
+		ID:       "ignored",
+		Provider: retool.ObservabilityDatadog,
+		Enabled:  true,
+		Config:   retool.ObservabilityProviderConfig{Site: "datadoghq.eu", APIKey: "dd-key"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "obs_1", config.ID)
+	assert.Equal(t, "datadoghq.eu", config.Config.Site)
+}
+
+func TestObservabilityConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config retool.ObservabilityConfig
+		err    string
+	}{
+		{"datadog", retool.ObservabilityConfig{Provider: retool.ObservabilityDatadog, Config: retool.ObservabilityProviderConfig{APIKey: "key"}}, ""},
+		{"datadog without api key", retool.ObservabilityConfig{Provider: retool.ObservabilityDatadog}, "api key is required for datadog"},
+		{"sentry", retool.ObservabilityConfig{Provider: retool.ObservabilitySentry, Config: retool.ObservabilityProviderConfig{DSN: "https://key@o1.ingest.sentry.io/42", SampleRate: 0.5}}, ""},
+		{"sentry without project", retool.ObservabilityConfig{Provider: retool.ObservabilitySentry, Config: retool.ObservabilityProviderConfig{DSN: "https://key@o1.ingest.sentry.io"}}, "invalid dsn: expected https://<public key>@<host>/<project id>"},
+		{"sentry sample rate", retool.ObservabilityConfig{Provider: retool.ObservabilitySentry, Config: retool.ObservabilityProviderConfig{DSN: "https://key@o1.ingest.sentry.io/42", SampleRate: 2}}, "invalid sample rate: 2"},
+		{"otlp", retool.ObservabilityConfig{Provider: retool.ObservabilityOTLP, Config: retool.ObservabilityProviderConfig{Endpoint: "https://otel.example.com:4318", Protocol: retool.OTLPProtocolHTTP}}, ""},
+		{"otlp relative endpoint", retool.ObservabilityConfig{Provider: retool.ObservabilityOTLP, Config: retool.ObservabilityProviderConfig{Endpoint: "otel:4317"}}, "invalid endpoint: otel:4317"},
+		{"otlp protocol", retool.ObservabilityConfig{Provider: retool.ObservabilityOTLP, Config: retool.ObservabilityProviderConfig{Endpoint: "https://otel.example.com", Protocol: "thrift"}}, "invalid otlp protocol: thrift"},
+		{"unknown provider", retool.ObservabilityConfig{Provider: "newrelic"}, "invalid observability provider: newrelic"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestObservabilityProviderConfig_String(t *testing.T) {
+	config := retool.ObservabilityProviderConfig{
+		APIKey:   "dd-key",
+		DSN:      "https://sentry-key@o1.ingest.sentry.io/42",
+		Endpoint: "https://otel.example.com",
+		Headers:  map[string]string{"x-api-key": "otlp-secret", "x-tenant": "acme"},
+	}
+
+	for _, output := range []string{config.String(), fmt.Sprintf("%v", config), fmt.Sprintf("%+v", config), fmt.Sprintf("%#v", config)} {
+		assert.NotContains(t, output, "dd-key")
+		assert.NotContains(t, output, "sentry-key")
+		assert.NotContains(t, output, "otlp-secret")
+		assert.NotContains(t, output, "acme")
+		assert.Contains(t, output, "x-api-key:[REDACTED]")
+		assert.Contains(t, output, "https://otel.example.com")
+	}
+}
+
+func TestUpdateObservabilityConfig_Invalid(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://retool.example.com")
+	assert.NoError(t, err)
+
+	_, err = client.UpdateObservabilityConfig(context.Background(), "obs_1", nil)
+	assert.EqualError(t, err, "observability config cannot be nil")
+
+	_, err = client.UpdateObservabilityConfig(context.Background(), "obs_1", &retool.ObservabilityConfig{Provider: retool.ObservabilitySentry})
+	assert.EqualError(t, err, "validating observability config: dsn is required for sentry")
+}
+
+func TestTestObservabilityConnection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/observability/test_connection", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"success": false, "message": "collector unreachable"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	result, err := client.TestObservabilityConnection(context.Background(), &retool.ObservabilityConfig{
+		Provider: retool.ObservabilityOTLP,
+		Config:   retool.ObservabilityProviderConfig{Endpoint: "https://otel.example.com"},
+	})
+	assert.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, "collector unreachable", result.Message)
+}
+
+func TestDeleteObservabilityConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api/v2/observability/configs/obs_1", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.DeleteObservabilityConfig(context.Background(), "obs_1"))
+}
@@ -0,0 +1,91 @@
+package retoolsdk
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+	"net/url"
+)
+
+// Page is a single page of results from a paginated endpoint.
+// NextToken is the pagination token of the following page. Passing it as the start token of a page iterator
+// resumes the listing from that page, so long-running jobs can checkpoint their progress.
+type Page[T any] struct {
+	Items      []T
+	NextToken  string
+	HasMore    bool
+	TotalCount int
+}
+
+// paginatePages returns an iterator that fetches pages lazily, starting at startToken (or the first page when empty).
+// No further pages are fetched once the caller stops iterating, the context is done or an error is yielded.
+func paginatePages[T any](ctx context.Context, client *Client, method, baseURL string, body []byte, query url.Values, startToken string) iter.Seq2[*Page[T], error] {
+	return func(yield func(*Page[T], error) bool) {
+		query := maps.Clone(query)
+		if query == nil {
+			query = make(url.Values)
+		}
+		nextToken := startToken
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if nextToken != "" {
+				query.Set("next", nextToken)
+			}
+
+			urlWithQuery := baseURL
+			if len(query) > 0 {
+				urlWithQuery = fmt.Sprintf("%s?%s", baseURL, query.Encode())
+			}
+
+			resp, err := client.Do(ctx, method, urlWithQuery, body)
+			if err != nil {
+				yield(nil, fmt.Errorf("making request: %w", err))
+				return
+			}
+
+			responseData, err := decodeResponse[[]T](resp)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			page := &Page[T]{
+				Items:      responseData.Data,
+				NextToken:  responseData.NextToken,
+				HasMore:    responseData.HasMore,
+				TotalCount: responseData.TotalCount,
+			}
+
+			if !yield(page, nil) || !page.HasMore || page.NextToken == "" {
+				return
+			}
+
+			nextToken = page.NextToken
+		}
+	}
+}
+
+// paginateItems flattens a page iterator into an iterator over the individual items.
+func paginateItems[T any](pages iter.Seq2[*Page[T], error]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range pages {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
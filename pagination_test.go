@@ -0,0 +1,164 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newPaginatedUsersServer serves three pages of users, chained with the tokens "page2" and "page3".
+func newPaginatedUsersServer(requests *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Query().Get("next") {
+		case "":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "user_1"}, {"id": "user_2"}], "next_token": "page2", "has_more": true, "total_count": 5}`)
+		case "page2":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "user_3"}, {"id": "user_4"}], "next_token": "page3", "has_more": true, "total_count": 5}`)
+		case "page3":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "user_5"}], "has_more": false, "total_count": 5}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, `{"success": false, "message": "invalid pagination token"}`)
+		}
+	}))
+}
+
+func TestAllUsers_IteratesAllPages(t *testing.T) {
+	var requests atomic.Int32
+	server := newPaginatedUsersServer(&requests)
+	defer server.Close()
+
+	client := &retool.Client{BaseURL: server.URL, HTTPClient: &http.Client{}}
+
+	var ids []string
+	for user, err := range client.AllUsers(context.Background(), nil) {
+		assert.NoError(t, err)
+		ids = append(ids, user.ID)
+	}
+
+	assert.Equal(t, []string{"user_1", "user_2", "user_3", "user_4", "user_5"}, ids)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestAllUsers_StopsFetchingOnBreak(t *testing.T) {
+	var requests atomic.Int32
+	server := newPaginatedUsersServer(&requests)
+	defer server.Close()
+
+	client := &retool.Client{BaseURL: server.URL, HTTPClient: &http.Client{}}
+
+	var ids []string
+	for user, err := range client.AllUsers(context.Background(), nil) {
+		assert.NoError(t, err)
+		ids = append(ids, user.ID)
+		if user.ID == "user_2" {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"user_1", "user_2"}, ids)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestAllUsers_PassesFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "jane.doe@example.com", r.URL.Query().Get("email"))
+		assert.Len(t, r.URL.Query()["email"], 1)
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "user_1"}], "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client := &retool.Client{BaseURL: server.URL, HTTPClient: &http.Client{}}
+
+	for user, err := range client.AllUsers(context.Background(), &retool.ListUserOpts{Email: "jane.doe@example.com"}) {
+		assert.NoError(t, err)
+		assert.Equal(t, "user_1", user.ID)
+	}
+}
+
+func TestUserPages_ResumeFromToken(t *testing.T) {
+	var requests atomic.Int32
+	server := newPaginatedUsersServer(&requests)
+	defer server.Close()
+
+	client := &retool.Client{BaseURL: server.URL, HTTPClient: &http.Client{}}
+
+	var checkpoint string
+	for page, err := range client.UserPages(context.Background(), nil, "") {
+		assert.NoError(t, err)
+		assert.Equal(t, 5, page.TotalCount)
+		checkpoint = page.NextToken
+		break
+	}
+	assert.Equal(t, "page2", checkpoint)
+
+	var ids []string
+	var pages int
+	for page, err := range client.UserPages(context.Background(), nil, checkpoint) {
+		assert.NoError(t, err)
+		pages++
+		for _, user := range page.Items {
+			ids = append(ids, user.ID)
+		}
+	}
+
+	assert.Equal(t, 2, pages)
+	assert.Equal(t, []string{"user_3", "user_4", "user_5"}, ids)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestUserPages_Error(t *testing.T) {
+	var requests atomic.Int32
+	server := newPaginatedUsersServer(&requests)
+	defer server.Close()
+
+	client := &retool.Client{BaseURL: server.URL, HTTPClient: &http.Client{}}
+
+	var errs []error
+	for page, err := range client.UserPages(context.Background(), nil, "unknown") {
+		assert.Nil(t, page)
+		errs = append(errs, err)
+	}
+
+	assert.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], retool.ErrBadRequest)
+}
+
+func TestAllGroups_ContextCanceled(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": 1, "name": "Admins"}], "next_token": "next", "has_more": true}`)
+	}))
+	defer server.Close()
+
+	client := &retool.Client{BaseURL: server.URL, HTTPClient: &http.Client{}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var names []string
+	var lastErr error
+	for group, err := range client.AllGroups(ctx) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		names = append(names, group.Name)
+		cancel()
+	}
+
+	assert.Equal(t, []string{"Admins"}, names)
+	assert.ErrorIs(t, lastErr, context.Canceled)
+	assert.Equal(t, int32(1), requests.Load())
+}
@@ -1,6 +1,7 @@
 package retoolsdk
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -99,13 +100,17 @@ func (o *ObjectType) Validate() error {
 // GetFolderOrAppAccessList Returns the list of users/groups and corresponding access levels whom have access to a
 // selected folder/page. The API token must have the "Permissions > Read" scope.
 // Supported from onprem edge version 3.96.0+ and 3.114-stable+.
-func (c *Client) GetFolderOrAppAccessList(objectID, objectType ObjectType) (*GroupedData, error) {
+func (c *Client) GetFolderOrAppAccessList(ctx context.Context, objectID, objectType ObjectType) (*GroupedData, error) {
 	if err := objectType.Validate(); err != nil {
 		return nil, fmt.Errorf("validating object type: %w", err)
 	}
 
+	if err := c.requireFeature(ctx, FeatureAccessList); err != nil {
+		return nil, err
+	}
+
 	baseURL := fmt.Sprintf("%s/permissions/accessList/%s/%s", c.BaseURL, objectType, objectID)
-	return doSingleRequest[GroupedData](c, "GET", baseURL, nil)
+	return doSingleRequest[GroupedData](ctx, c, "GET", baseURL, nil)
 }
 
 // ListGroupObjectPermissions returns the list of objects with corresponding access levels that a subject (group) has
@@ -113,7 +118,7 @@ func (c *Client) GetFolderOrAppAccessList(objectID, objectType ObjectType) (*Gro
 // Folders are supported from API version 2.0.0 + and onprem version 3.18+,
 // apps are supported from API version 2.4.0+ and onprem version 3.26.0+,
 // resources and resource_configurations are supported from onprem edge version 3.37.0+ and 3.47-stable+.
-func (c *Client) ListGroupObjectPermissions(subject string, objectType ObjectType, id any) ([]Subject, error) {
+func (c *Client) ListGroupObjectPermissions(ctx context.Context, subject string, objectType ObjectType, id any) ([]Subject, error) {
 	if err := objectType.Validate(); err != nil {
 		return nil, fmt.Errorf("validating object type: %w", err)
 	}
@@ -122,6 +127,10 @@ func (c *Client) ListGroupObjectPermissions(subject string, objectType ObjectTyp
 		return nil, fmt.Errorf("invalid subject: %s", subject)
 	}
 
+	if err := c.requireFeature(ctx, objectTypeFeature(objectType)); err != nil {
+		return nil, err
+	}
+
 	var requestBody any
 
 	if subject == "group" {
@@ -176,10 +185,10 @@ func (c *Client) ListGroupObjectPermissions(subject string, objectType ObjectTyp
 	}
 
 	baseURL := fmt.Sprintf("%s/permissions/listObjects", c.BaseURL)
-	return doPaginatedRequest[Subject](c, "POST", baseURL, requestBodyJSON, url.Values{})
+	return doPaginatedRequest[Subject](ctx, c, "POST", baseURL, requestBodyJSON, url.Values{})
 }
 
-func (c *Client) GrantPermission(subject string, subjectID any, objectType ObjectType, objectID string, accessLevel AccessLevel) ([]Subject, error) {
+func (c *Client) GrantPermission(ctx context.Context, subject string, subjectID any, objectType ObjectType, objectID string, accessLevel AccessLevel) ([]Subject, error) {
 	if err := objectType.Validate(); err != nil {
 		return nil, fmt.Errorf("validating object type: %w", err)
 	}
@@ -188,6 +197,10 @@ func (c *Client) GrantPermission(subject string, subjectID any, objectType Objec
 		return nil, fmt.Errorf("invalid subject: %s", subject)
 	}
 
+	if err := c.requireFeature(ctx, objectTypeFeature(objectType)); err != nil {
+		return nil, err
+	}
+
 	var requestBody any
 
 	if subject == "group" {
@@ -264,10 +277,10 @@ func (c *Client) GrantPermission(subject string, subjectID any, objectType Objec
 	}
 
 	baseURL := fmt.Sprintf("%s/permissions/grant", c.BaseURL)
-	return doPaginatedRequest[Subject](c, "POST", baseURL, requestBodyJSON, url.Values{})
+	return doPaginatedRequest[Subject](ctx, c, "POST", baseURL, requestBodyJSON, url.Values{})
 }
 
-func (c *Client) RevokePermission(subject string, subjectID any, objectType ObjectType, objectID string) ([]Subject, error) {
+func (c *Client) RevokePermission(ctx context.Context, subject string, subjectID any, objectType ObjectType, objectID string) ([]Subject, error) {
 	if err := objectType.Validate(); err != nil {
 		return nil, fmt.Errorf("validating object type: %w", err)
 	}
@@ -276,6 +289,10 @@ func (c *Client) RevokePermission(subject string, subjectID any, objectType Obje
 		return nil, fmt.Errorf("invalid subject: %s", subject)
 	}
 
+	if err := c.requireFeature(ctx, objectTypeFeature(objectType)); err != nil {
+		return nil, err
+	}
+
 	var requestBody any
 
 	if subject == "group" {
@@ -347,5 +364,5 @@ func (c *Client) RevokePermission(subject string, subjectID any, objectType Obje
 		return nil, fmt.Errorf("marshalling request: %w", err)
 	}
 	baseURL := fmt.Sprintf("%s/permissions/revoke", c.BaseURL)
-	return doPaginatedRequest[Subject](c, "POST", baseURL, requestBodyJSON, url.Values{})
+	return doPaginatedRequest[Subject](ctx, c, "POST", baseURL, requestBodyJSON, url.Values{})
 }
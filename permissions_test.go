@@ -2,6 +2,7 @@ package retoolsdk_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	retool "github.com/thoughtgears/retoolsdk"
@@ -128,7 +129,7 @@ func TestGetFolderOrAppAccessList_Success(t *testing.T) {
 		},
 	}
 
-	accessData, err := client.GetFolderOrAppAccessList("123", "app")
+	accessData, err := client.GetFolderOrAppAccessList(context.Background(), "123", "app")
 
 	assert.NoError(t, err)
 	assert.NotNil(t, accessData)
@@ -157,7 +158,7 @@ func TestGetFolderOrAppAccessList_Failure(t *testing.T) {
 		},
 	}
 
-	accessData, err := client.GetFolderOrAppAccessList("123", "app")
+	accessData, err := client.GetFolderOrAppAccessList(context.Background(), "123", "app")
 
 	assert.Error(t, err)
 	assert.Nil(t, accessData)
@@ -192,7 +193,7 @@ func TestListGroupObjectPermissions_Success(t *testing.T) {
 		},
 	}
 
-	resp, err := client.ListGroupObjectPermissions("group", "folder", 123)
+	resp, err := client.ListGroupObjectPermissions(context.Background(), "group", "folder", 123)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -252,7 +253,7 @@ func TestListGroupObjectPermissions_Pagination(t *testing.T) {
 		},
 	}
 
-	resp, err := client.ListGroupObjectPermissions("group", "folder", 123)
+	resp, err := client.ListGroupObjectPermissions(context.Background(), "group", "folder", 123)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -280,7 +281,7 @@ func TestListGroupObjectPermissions_Failure(t *testing.T) {
 		},
 	}
 
-	resp, err := client.ListGroupObjectPermissions("group", "folder", 123)
+	resp, err := client.ListGroupObjectPermissions(context.Background(), "group", "folder", 123)
 
 	assert.Error(t, err)
 	assert.Nil(t, resp)
@@ -312,7 +313,7 @@ func TestGrantPermission_Success(t *testing.T) {
 		},
 	}
 
-	subjects, err := client.GrantPermission("user", "user_123", retool.ObjectType("app"), "app_123", retool.AccessLevel("own"))
+	subjects, err := client.GrantPermission(context.Background(), "user", "user_123", retool.ObjectType("app"), "app_123", retool.AccessLevel("own"))
 	assert.NoError(t, err)
 	assert.NotNil(t, subjects)
 	assert.Equal(t, "user_123", subjects[0].ID)
@@ -339,7 +340,7 @@ func TestGrantPermission_Failure(t *testing.T) {
 		},
 	}
 
-	subjects, err := client.GrantPermission("user", "user_123", retool.ObjectType("app"), "app_123", retool.AccessLevel("invalid"))
+	subjects, err := client.GrantPermission(context.Background(), "user", "user_123", retool.ObjectType("app"), "app_123", retool.AccessLevel("invalid"))
 	assert.Error(t, err)
 	assert.Nil(t, subjects)
 	assert.Equal(t, "Invalid access level", err.Error())
@@ -394,7 +395,7 @@ func TestGrantPermission_Pagination(t *testing.T) {
 		},
 	}
 
-	subjects, err := client.GrantPermission("user", "user_123", retool.ObjectType("app"), "app_123", retool.AccessLevel("own"))
+	subjects, err := client.GrantPermission(context.Background(), "user", "user_123", retool.ObjectType("app"), "app_123", retool.AccessLevel("own"))
 	assert.NoError(t, err)
 	assert.NotNil(t, subjects)
 	assert.Len(t, subjects, 2)
@@ -427,7 +428,7 @@ func TestRevokePermission_Success(t *testing.T) {
 		},
 	}
 
-	subjects, err := client.RevokePermission("user", "user_123", retool.ObjectType("app"), "app_123")
+	subjects, err := client.RevokePermission(context.Background(), "user", "user_123", retool.ObjectType("app"), "app_123")
 	assert.NoError(t, err)
 	assert.NotNil(t, subjects)
 	assert.Equal(t, "user_123", subjects[0].ID)
@@ -454,7 +455,7 @@ func TestRevokePermission_Failure(t *testing.T) {
 		},
 	}
 
-	subjects, err := client.RevokePermission("user", "user_123", retool.ObjectType("app"), "app_123")
+	subjects, err := client.RevokePermission(context.Background(), "user", "user_123", retool.ObjectType("app"), "app_123")
 	assert.Error(t, err)
 	assert.Nil(t, subjects)
 	assert.Equal(t, "User not found", err.Error())
@@ -509,7 +510,7 @@ func TestRevokePermission_Pagination(t *testing.T) {
 		},
 	}
 
-	subjects, err := client.RevokePermission("user", "user_123", retool.ObjectType("app"), "app_123")
+	subjects, err := client.RevokePermission(context.Background(), "user", "user_123", retool.ObjectType("app"), "app_123")
 	assert.NoError(t, err)
 	assert.NotNil(t, subjects)
 	assert.Len(t, subjects, 2)
@@ -0,0 +1,189 @@
+package retoolsdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit is a token bucket budget allowing RequestsPerSecond requests on average with bursts of up to Burst requests.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// Validate ensures that the values provided in RateLimit are usable.
+func (r *RateLimit) Validate() error {
+	if r.RequestsPerSecond <= 0 {
+		return errors.New("rate limit requests per second must be greater than 0")
+	}
+
+	if r.Burst < 1 {
+		return errors.New("rate limit burst must be at least 1")
+	}
+
+	return nil
+}
+
+// WithRateLimit limits the client to rps requests per second with bursts of up to burst requests.
+// The budget is shared by every goroutine using the client. When the server responds with 429 and rate limit
+// headers, requests are held back until the server side limit resets.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) error {
+		limit := RateLimit{RequestsPerSecond: rps, Burst: burst}
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+
+		bucket := newTokenBucket(limit)
+		c.rateLimiter = &transportWithRateLimit{reads: bucket, writes: bucket}
+		return nil
+	}
+}
+
+// WithReadWriteRateLimit limits the client with separate budgets for reads (GET, HEAD and OPTIONS requests)
+// and writes (every other method).
+func WithReadWriteRateLimit(reads, writes RateLimit) ClientOption {
+	return func(c *Client) error {
+		if err := reads.Validate(); err != nil {
+			return err
+		}
+
+		if err := writes.Validate(); err != nil {
+			return err
+		}
+
+		c.rateLimiter = &transportWithRateLimit{reads: newTokenBucket(reads), writes: newTokenBucket(writes)}
+		return nil
+	}
+}
+
+// transportWithRateLimit is a custom transport that waits for a token from the read or write
+// budget before sending every request.
+type transportWithRateLimit struct {
+	reads     *tokenBucket
+	writes    *tokenBucket
+	Transport http.RoundTripper
+}
+
+// RoundTrip waits for the rate limit budget of the request method, sends the request and pauses
+// the budget when the response reports that the server side limit is exhausted.
+func (t *transportWithRateLimit) RoundTrip(req *http.Request) (*http.Response, error) {
+	bucket := t.writes
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		bucket = t.reads
+	}
+
+	if err := bucket.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if until, ok := rateLimitReset(resp, time.Now()); ok {
+		bucket.pause(until)
+	}
+
+	return resp, nil
+}
+
+// rateLimitReset returns the time at which the server side rate limit resets, when the response reports
+// it as exhausted: either a 429 with Retry-After or X-RateLimit-Reset, or X-RateLimit-Remaining of 0.
+// X-RateLimit-Reset is accepted both as a number of seconds and as a Unix timestamp.
+func rateLimitReset(resp *http.Response, now time.Time) (time.Time, bool) {
+	exhausted := resp.StatusCode == http.StatusTooManyRequests || resp.Header.Get("X-RateLimit-Remaining") == "0"
+	if !exhausted {
+		return time.Time{}, false
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return now.Add(wait), true
+		}
+	}
+
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil || reset < 0 {
+		return time.Time{}, false
+	}
+
+	// Values larger than a year in seconds can only be Unix timestamps.
+	if reset > int64((365 * 24 * time.Hour).Seconds()) {
+		return time.Unix(reset, 0), true
+	}
+
+	return now.Add(time.Duration(reset) * time.Second), true
+}
+
+// tokenBucket is a token bucket rate limiter safe for concurrent use. Callers reserve a token up front, so
+// tokens may go negative, which queues callers in the order in which they arrived.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns a full token bucket for the rate limit.
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	return &tokenBucket{
+		rate:   limit.RequestsPerSecond,
+		burst:  float64(limit.Burst),
+		tokens: float64(limit.Burst),
+		last:   time.Now(),
+	}
+}
+
+// refill adds the tokens accumulated since the last refill. Must be called with mu held.
+func (b *tokenBucket) refill(now time.Time) {
+	if now.After(b.last) {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+// wait reserves a token and blocks until it becomes available or the context is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.refill(now)
+	b.tokens--
+
+	var delay time.Duration
+	if b.last.After(now) {
+		delay = b.last.Sub(now)
+	}
+	if b.tokens < 0 {
+		delay += time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+
+	if err := sleepContext(ctx, delay); err != nil {
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// pause stops handing out tokens until the given time and drops any remaining burst.
+func (b *tokenBucket) pause(until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if until.After(b.last) {
+		b.refill(time.Now())
+		b.tokens = min(b.tokens, 0)
+		b.last = until
+	}
+}
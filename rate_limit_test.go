@@ -0,0 +1,129 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRateLimitTestServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "user_123"}}`)
+	}))
+}
+
+func TestRateLimit_LimitsRequests(t *testing.T) {
+	server := newRateLimitTestServer()
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithRateLimit(20, 2))
+	assert.NoError(t, err)
+
+	start := time.Now()
+	for range 4 {
+		_, err := client.GetUser(context.Background(), "user_123")
+		assert.NoError(t, err)
+	}
+
+	// Two requests are served from the burst, the remaining two wait 50ms each.
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimit_SharedAcrossGoroutines(t *testing.T) {
+	server := newRateLimitTestServer()
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithRateLimit(50, 1))
+	assert.NoError(t, err)
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetUser(context.Background(), "user_123")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.GreaterOrEqual(t, time.Since(start), 75*time.Millisecond)
+}
+
+func TestRateLimit_SeparateReadWriteBudgets(t *testing.T) {
+	server := newRateLimitTestServer()
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithReadWriteRateLimit(
+		retool.RateLimit{RequestsPerSecond: 1000, Burst: 10},
+		retool.RateLimit{RequestsPerSecond: 0.1, Burst: 1},
+	))
+	assert.NoError(t, err)
+
+	_, err = client.CreateUser(context.Background(), "jane.doe@example.com", "Jane", "Doe", nil)
+	assert.NoError(t, err)
+
+	start := time.Now()
+	for range 5 {
+		_, err := client.GetUser(context.Background(), "user_123")
+		assert.NoError(t, err)
+	}
+	assert.Less(t, time.Since(start), time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = client.CreateUser(ctx, "john.doe@example.com", "John", "Doe", nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimit_PausesOnTooManyRequests(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprintln(w, `{"success": false, "message": "Too many requests"}`)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "user_123"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithRateLimit(100, 10))
+	assert.NoError(t, err)
+
+	_, err = client.GetUser(context.Background(), "user_123")
+	assert.ErrorIs(t, err, retool.ErrRateLimited)
+
+	start := time.Now()
+	_, err = client.GetUser(context.Background(), "user_123")
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+}
+
+func TestWithRateLimit_Invalid(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "example.com", retool.WithRateLimit(0, 1))
+	assert.Nil(t, client)
+	assert.EqualError(t, err, "applying client option: rate limit requests per second must be greater than 0")
+
+	client, err = retool.NewClient("test-api-key", "example.com", retool.WithReadWriteRateLimit(
+		retool.RateLimit{RequestsPerSecond: 1, Burst: 1},
+		retool.RateLimit{RequestsPerSecond: 1, Burst: 0},
+	))
+	assert.Nil(t, client)
+	assert.EqualError(t, err, "applying client option: rate limit burst must be at least 1")
+}
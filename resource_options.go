@@ -0,0 +1,289 @@
+package retoolsdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Resource types with typed options.
+const (
+	ResourceTypePostgreSQL = "postgresql"
+	ResourceTypeMySQL      = "mysql"
+	ResourceTypeRESTAPI    = "restapi"
+	ResourceTypeGraphQL    = "graphql"
+	ResourceTypeS3         = "s3"
+)
+
+// ResourceOptions is the connection configuration of a resource. The implementations in this package never include
+// secret fields in their String output, so options can be logged and printed safely.
+type ResourceOptions interface {
+	// ResourceType returns the type of the resource the options configure.
+	ResourceType() string
+	// Validate ensures that the options have valid values.
+	Validate() error
+	fmt.Stringer
+}
+
+// DecodeResourceOptions decodes the options of a resource of the given type into its typed option struct. The
+// options are returned as values, such as PostgreSQLOptions, matching the form CreateResource and UpdateResource
+// accept, so decoded options can be modified and sent back.
+func DecodeResourceOptions(resourceType string, options json.RawMessage) (ResourceOptions, error) {
+	switch resourceType {
+	case ResourceTypePostgreSQL:
+		return decodeOptions[PostgreSQLOptions](resourceType, options)
+	case ResourceTypeMySQL:
+		return decodeOptions[MySQLOptions](resourceType, options)
+	case ResourceTypeRESTAPI:
+		return decodeOptions[RESTAPIOptions](resourceType, options)
+	case ResourceTypeGraphQL:
+		return decodeOptions[GraphQLOptions](resourceType, options)
+	case ResourceTypeS3:
+		return decodeOptions[S3Options](resourceType, options)
+	default:
+		return nil, fmt.Errorf("no typed options for resource type: %s", resourceType)
+	}
+}
+
+// decodeOptions decodes the options into the option struct T.
+func decodeOptions[T ResourceOptions](resourceType string, options json.RawMessage) (ResourceOptions, error) {
+	var typed T
+
+	if len(options) == 0 {
+		return typed, nil
+	}
+
+	if err := json.Unmarshal(options, &typed); err != nil {
+		return nil, fmt.Errorf("decoding %s options: %w", resourceType, err)
+	}
+
+	return typed, nil
+}
+
+// secret returns the placeholder printed instead of a secret value, or an empty string when it is unset.
+func secret(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return redacted
+}
+
+// formatHeaders prints header names with their values redacted, as headers often carry credentials.
+func formatHeaders(headers map[string]string) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for i, name := range names {
+		names[i] = fmt.Sprintf("%s:%s", name, secret(headers[name]))
+	}
+
+	return "map[" + strings.Join(names, " ") + "]"
+}
+
+// databaseOptions holds the validation and formatting shared by PostgreSQLOptions and MySQLOptions.
+type databaseOptions struct {
+	Host             string `json:"host"`
+	Port             int    `json:"port"`
+	DatabaseName     string `json:"database_name"`
+	DatabaseUsername string `json:"database_username"`
+	DatabasePassword string `json:"database_password,omitempty"`
+	SSLEnabled       bool   `json:"ssl_enabled"`
+}
+
+func (o databaseOptions) validate() error {
+	if o.Host == "" {
+		return errors.New("host is required")
+	}
+
+	if o.Port < 0 || o.Port > 65535 {
+		return fmt.Errorf("invalid port: %d", o.Port)
+	}
+
+	if o.DatabaseName == "" {
+		return errors.New("database name is required")
+	}
+
+	return nil
+}
+
+func (o databaseOptions) format(name string) string {
+	return fmt.Sprintf("%s{Host:%s Port:%d DatabaseName:%s DatabaseUsername:%s DatabasePassword:%s SSLEnabled:%t}",
+		name, o.Host, o.Port, o.DatabaseName, o.DatabaseUsername, secret(o.DatabasePassword), o.SSLEnabled)
+}
+
+// PostgreSQLOptions are the connection options of a PostgreSQL resource.
+type PostgreSQLOptions struct {
+	Host             string `json:"host"`
+	Port             int    `json:"port"`
+	DatabaseName     string `json:"database_name"`
+	DatabaseUsername string `json:"database_username"`
+	DatabasePassword string `json:"database_password,omitempty"`
+	SSLEnabled       bool   `json:"ssl_enabled"`
+}
+
+// ResourceType returns ResourceTypePostgreSQL.
+func (o PostgreSQLOptions) ResourceType() string { return ResourceTypePostgreSQL }
+
+// Validate ensures that the options have valid values.
+func (o PostgreSQLOptions) Validate() error { return databaseOptions(o).validate() }
+
+// String returns the options with DatabasePassword redacted.
+func (o PostgreSQLOptions) String() string { return databaseOptions(o).format("PostgreSQLOptions") }
+
+// GoString returns the options with DatabasePassword redacted.
+func (o PostgreSQLOptions) GoString() string { return o.String() }
+
+// MySQLOptions are the connection options of a MySQL resource.
+type MySQLOptions struct {
+	Host             string `json:"host"`
+	Port             int    `json:"port"`
+	DatabaseName     string `json:"database_name"`
+	DatabaseUsername string `json:"database_username"`
+	DatabasePassword string `json:"database_password,omitempty"`
+	SSLEnabled       bool   `json:"ssl_enabled"`
+}
+
+// ResourceType returns ResourceTypeMySQL.
+func (o MySQLOptions) ResourceType() string { return ResourceTypeMySQL }
+
+// Validate ensures that the options have valid values.
+func (o MySQLOptions) Validate() error { return databaseOptions(o).validate() }
+
+// String returns the options with DatabasePassword redacted.
+func (o MySQLOptions) String() string { return databaseOptions(o).format("MySQLOptions") }
+
+// GoString returns the options with DatabasePassword redacted.
+func (o MySQLOptions) GoString() string { return o.String() }
+
+// Authentication methods of REST API and GraphQL resources.
+const (
+	AuthenticationNone   = "none"
+	AuthenticationBasic  = "basic"
+	AuthenticationBearer = "bearer"
+)
+
+// httpOptions holds the validation and formatting shared by RESTAPIOptions and GraphQLOptions.
+type httpOptions struct {
+	BaseURL        string            `json:"base_url"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	Authentication string            `json:"authentication,omitempty"`
+	Username       string            `json:"basic_auth_username,omitempty"`
+	Password       string            `json:"basic_auth_password,omitempty"`
+	BearerToken    string            `json:"bearer_token,omitempty"`
+}
+
+func (o httpOptions) validate() error {
+	if o.BaseURL == "" {
+		return errors.New("base url is required")
+	}
+
+	switch o.Authentication {
+	case "", AuthenticationNone:
+	case AuthenticationBasic:
+		if o.Username == "" {
+			return errors.New("username is required for basic authentication")
+		}
+	case AuthenticationBearer:
+		if o.BearerToken == "" {
+			return errors.New("bearer token is required for bearer authentication")
+		}
+	default:
+		return fmt.Errorf("invalid authentication: %s", o.Authentication)
+	}
+
+	return nil
+}
+
+func (o httpOptions) format(name string) string {
+	return fmt.Sprintf("%s{BaseURL:%s Headers:%s Authentication:%s Username:%s Password:%s BearerToken:%s}",
+		name, o.BaseURL, formatHeaders(o.Headers), o.Authentication, o.Username, secret(o.Password), secret(o.BearerToken))
+}
+
+// RESTAPIOptions are the connection options of a REST API resource.
+type RESTAPIOptions struct {
+	BaseURL        string            `json:"base_url"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	Authentication string            `json:"authentication,omitempty"`
+	Username       string            `json:"basic_auth_username,omitempty"`
+	Password       string            `json:"basic_auth_password,omitempty"`
+	BearerToken    string            `json:"bearer_token,omitempty"`
+}
+
+// ResourceType returns ResourceTypeRESTAPI.
+func (o RESTAPIOptions) ResourceType() string { return ResourceTypeRESTAPI }
+
+// Validate ensures that the options have valid values.
+func (o RESTAPIOptions) Validate() error { return httpOptions(o).validate() }
+
+// String returns the options with Password, BearerToken and header values redacted.
+func (o RESTAPIOptions) String() string { return httpOptions(o).format("RESTAPIOptions") }
+
+// GoString returns the options with Password, BearerToken and header values redacted.
+func (o RESTAPIOptions) GoString() string { return o.String() }
+
+// GraphQLOptions are the connection options of a GraphQL resource.
+type GraphQLOptions struct {
+	BaseURL        string            `json:"base_url"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	Authentication string            `json:"authentication,omitempty"`
+	Username       string            `json:"basic_auth_username,omitempty"`
+	Password       string            `json:"basic_auth_password,omitempty"`
+	BearerToken    string            `json:"bearer_token,omitempty"`
+}
+
+// ResourceType returns ResourceTypeGraphQL.
+func (o GraphQLOptions) ResourceType() string { return ResourceTypeGraphQL }
+
+// Validate ensures that the options have valid values.
+func (o GraphQLOptions) Validate() error { return httpOptions(o).validate() }
+
+// String returns the options with Password, BearerToken and header values redacted.
+func (o GraphQLOptions) String() string { return httpOptions(o).format("GraphQLOptions") }
+
+// GoString returns the options with Password, BearerToken and header values redacted.
+func (o GraphQLOptions) GoString() string { return o.String() }
+
+// S3Options are the connection options of an Amazon S3 resource.
+type S3Options struct {
+	BucketName      string `json:"bucket_name"`
+	Region          string `json:"region"`
+	AccessKeyID     string `json:"access_key_id,omitempty"`
+	SecretAccessKey string `json:"secret_access_key,omitempty"`
+	// RoleARN is the IAM role assumed instead of using access keys.
+	RoleARN string `json:"role_arn,omitempty"`
+}
+
+// ResourceType returns ResourceTypeS3.
+func (o S3Options) ResourceType() string { return ResourceTypeS3 }
+
+// Validate ensures that the options have valid values.
+func (o S3Options) Validate() error {
+	if o.BucketName == "" {
+		return errors.New("bucket name is required")
+	}
+
+	if o.Region == "" {
+		return errors.New("region is required")
+	}
+
+	if (o.AccessKeyID == "") != (o.SecretAccessKey == "") {
+		return errors.New("access key id and secret access key must be set together")
+	}
+
+	return nil
+}
+
+// String returns the options with SecretAccessKey redacted.
+func (o S3Options) String() string {
+	return fmt.Sprintf("S3Options{BucketName:%s Region:%s AccessKeyID:%s SecretAccessKey:%s RoleARN:%s}",
+		o.BucketName, o.Region, o.AccessKeyID, secret(o.SecretAccessKey), o.RoleARN)
+}
+
+// GoString returns the options with SecretAccessKey redacted.
+func (o S3Options) GoString() string { return o.String() }
@@ -0,0 +1,81 @@
+package retoolsdk_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceOptions_StringRedactsSecrets(t *testing.T) {
+	options := []retool.ResourceOptions{
+		retool.PostgreSQLOptions{Host: "db.example.com", DatabaseName: "app", DatabasePassword: "pg-secret"},
+		retool.MySQLOptions{Host: "db.example.com", DatabaseName: "app", DatabasePassword: "mysql-secret"},
+		retool.RESTAPIOptions{BaseURL: "https://api.example.com", Password: "basic-secret", BearerToken: "bearer-secret",
+			Headers: map[string]string{"X-Api-Key": "header-secret"}},
+		retool.GraphQLOptions{BaseURL: "https://graph.example.com", BearerToken: "graphql-secret"},
+		&retool.S3Options{BucketName: "uploads", Region: "eu-west-1", AccessKeyID: "AKIA123", SecretAccessKey: "s3-secret"},
+	}
+
+	for _, option := range options {
+		t.Run(option.ResourceType(), func(t *testing.T) {
+			output := fmt.Sprintf("%s %v %+v %#v", option, option, option, option)
+			assert.NotContains(t, output, "secret")
+			assert.Contains(t, output, "[REDACTED]")
+		})
+	}
+}
+
+func TestResourceOptions_String(t *testing.T) {
+	options := retool.PostgreSQLOptions{Host: "db.example.com", Port: 5432, DatabaseName: "app", DatabaseUsername: "retool"}
+	assert.Equal(t, "PostgreSQLOptions{Host:db.example.com Port:5432 DatabaseName:app DatabaseUsername:retool DatabasePassword: SSLEnabled:false}", options.String())
+
+	rest := retool.RESTAPIOptions{BaseURL: "https://api.example.com", Headers: map[string]string{"B": "2", "A": "1"}}
+	assert.Contains(t, rest.String(), "Headers:map[A:[REDACTED] B:[REDACTED]]")
+}
+
+func TestResourceOptions_JSONKeepsSecrets(t *testing.T) {
+	encoded, err := json.Marshal(retool.S3Options{BucketName: "uploads", Region: "eu-west-1", AccessKeyID: "AKIA123", SecretAccessKey: "s3-secret"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"bucket_name": "uploads", "region": "eu-west-1", "access_key_id": "AKIA123", "secret_access_key": "s3-secret"}`, string(encoded))
+}
+
+func TestResourceOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options retool.ResourceOptions
+		err     string
+	}{
+		{"valid postgres", retool.PostgreSQLOptions{Host: "db", Port: 5432, DatabaseName: "app"}, ""},
+		{"invalid port", retool.PostgreSQLOptions{Host: "db", Port: 70000, DatabaseName: "app"}, "invalid port: 70000"},
+		{"missing database", retool.MySQLOptions{Host: "db"}, "database name is required"},
+		{"missing base url", retool.RESTAPIOptions{}, "base url is required"},
+		{"bearer without token", retool.GraphQLOptions{BaseURL: "https://graph.example.com", Authentication: retool.AuthenticationBearer}, "bearer token is required for bearer authentication"},
+		{"invalid authentication", retool.RESTAPIOptions{BaseURL: "https://api.example.com", Authentication: "oauth"}, "invalid authentication: oauth"},
+		{"missing region", retool.S3Options{BucketName: "uploads"}, "region is required"},
+		{"half access key", retool.S3Options{BucketName: "uploads", Region: "eu-west-1", AccessKeyID: "AKIA123"}, "access key id and secret access key must be set together"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.options.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestDecodeResourceOptions(t *testing.T) {
+	options, err := retool.DecodeResourceOptions(retool.ResourceTypeRESTAPI, json.RawMessage(`{"base_url": "https://api.example.com"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, retool.RESTAPIOptions{BaseURL: "https://api.example.com"}, options)
+
+	_, err = retool.DecodeResourceOptions("snowflake", nil)
+	assert.EqualError(t, err, "no typed options for resource type: snowflake")
+}
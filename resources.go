@@ -0,0 +1,256 @@
+package retoolsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+)
+
+// Resource is a struct that contains the information about a resource. Options holds the raw connection options
+// returned by the API; use TypedOptions to decode them for the resource types with typed options.
+type Resource struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	DisplayName string          `json:"display_name"`
+	FolderID    string          `json:"folder_id,omitempty"`
+	Options     json.RawMessage `json:"options,omitempty"`
+	CreatedAt   string          `json:"created_at"`
+	UpdatedAt   string          `json:"updated_at"`
+}
+
+// TypedOptions decodes the options of the resource into the typed option struct of its type.
+func (r *Resource) TypedOptions() (ResourceOptions, error) {
+	return DecodeResourceOptions(r.Type, r.Options)
+}
+
+// String returns a description of the resource without its options, which may hold secrets.
+func (r Resource) String() string {
+	return fmt.Sprintf("Resource{ID:%s Type:%s DisplayName:%s FolderID:%s}", r.ID, r.Type, r.DisplayName, r.FolderID)
+}
+
+// GoString returns a description of the resource without its options, which may hold secrets.
+func (r Resource) GoString() string { return r.String() }
+
+// ResourceConfiguration is the connection configuration of a resource for a single environment.
+type ResourceConfiguration struct {
+	ID            string          `json:"id"`
+	ResourceID    string          `json:"resource_id"`
+	EnvironmentID string          `json:"environment_id"`
+	Options       json.RawMessage `json:"options,omitempty"`
+	CreatedAt     string          `json:"created_at"`
+	UpdatedAt     string          `json:"updated_at"`
+}
+
+// String returns a description of the configuration without its options, which may hold secrets.
+func (r ResourceConfiguration) String() string {
+	return fmt.Sprintf("ResourceConfiguration{ID:%s ResourceID:%s EnvironmentID:%s}", r.ID, r.ResourceID, r.EnvironmentID)
+}
+
+// GoString returns a description of the configuration without its options, which may hold secrets.
+func (r ResourceConfiguration) GoString() string { return r.String() }
+
+// GetResource returns the resource with the given ID. The API token must have the "Resources > Read" scope.
+func (c *Client) GetResource(ctx context.Context, id string) (*Resource, error) {
+	baseURL := fmt.Sprintf("%s/resources/%s", c.BaseURL, id)
+	return doSingleRequest[Resource](ctx, c, "GET", baseURL, nil)
+}
+
+// ListResourcesOpts is a struct that contains optional query parameters for ListResources.
+type ListResourcesOpts struct {
+	// Type only returns the resources of the given type.
+	Type string
+	// FolderID only returns the resources in the folder with the given ID.
+	FolderID string
+}
+
+// values returns the query parameters for the options.
+func (o *ListResourcesOpts) values() url.Values {
+	query := make(url.Values)
+
+	if o == nil {
+		return query
+	}
+
+	if o.Type != "" {
+		query.Add("type", o.Type)
+	}
+	if o.FolderID != "" {
+		query.Add("folder_id", o.FolderID)
+	}
+
+	return query
+}
+
+// ListResources returns a list of resources. The API token must have the "Resources > Read" scope.
+func (c *Client) ListResources(ctx context.Context, opts *ListResourcesOpts) ([]Resource, error) {
+	baseURL := fmt.Sprintf("%s/resources", c.BaseURL)
+	return doPaginatedRequest[Resource](ctx, c, "GET", baseURL, nil, opts.values())
+}
+
+// AllResources returns an iterator over all resources, fetching pages lazily as the iteration progresses.
+// The API token must have the "Resources > Read" scope.
+func (c *Client) AllResources(ctx context.Context, opts *ListResourcesOpts) iter.Seq2[Resource, error] {
+	return paginateItems(c.ResourcePages(ctx, opts, ""))
+}
+
+// ResourcePages returns an iterator over the pages of resources, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Resources > Read" scope.
+func (c *Client) ResourcePages(ctx context.Context, opts *ListResourcesOpts, next string) iter.Seq2[*Page[Resource], error] {
+	baseURL := fmt.Sprintf("%s/resources", c.BaseURL)
+	return paginatePages[Resource](ctx, c, "GET", baseURL, nil, opts.values(), next)
+}
+
+// resourceRequest encodes the request body for creating and updating resources.
+func resourceRequest(displayName, folderID string, options ResourceOptions) ([]byte, error) {
+	if displayName == "" {
+		return nil, errors.New("display name cannot be empty")
+	}
+
+	if options == nil {
+		return nil, errors.New("resource options cannot be nil")
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, fmt.Errorf("validating %s options: %w", options.ResourceType(), err)
+	}
+
+	requestBody := struct {
+		Type        string          `json:"type"`
+		DisplayName string          `json:"display_name"`
+		FolderID    string          `json:"folder_id,omitempty"`
+		Options     ResourceOptions `json:"options"`
+	}{
+		Type:        options.ResourceType(),
+		DisplayName: displayName,
+		FolderID:    folderID,
+		Options:     options,
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	return requestBodyJSON, nil
+}
+
+// CreateResource creates a resource of the type of the options, optionally in a resource folder, and returns it.
+// The API token must have the "Resources > Write" scope.
+func (c *Client) CreateResource(ctx context.Context, displayName, folderID string, options ResourceOptions) (*Resource, error) {
+	requestBodyJSON, err := resourceRequest(displayName, folderID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/resources", c.BaseURL)
+	return doSingleRequest[Resource](ctx, c, "POST", baseURL, requestBodyJSON)
+}
+
+// UpdateResource replaces the display name, folder and options of a resource and returns the updated resource.
+// The type of the options must match the type of the resource. The API token must have the "Resources > Write" scope.
+func (c *Client) UpdateResource(ctx context.Context, id, displayName, folderID string, options ResourceOptions) (*Resource, error) {
+	requestBodyJSON, err := resourceRequest(displayName, folderID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/resources/%s", c.BaseURL, id)
+	return doSingleRequest[Resource](ctx, c, "PUT", baseURL, requestBodyJSON)
+}
+
+// DeleteResource deletes the resource with the given ID. The API token must have the "Resources > Write" scope.
+func (c *Client) DeleteResource(ctx context.Context, id string) error {
+	baseURL := fmt.Sprintf("%s/resources/%s", c.BaseURL, id)
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
+	return err
+}
+
+// ListResourceConfigurations returns the per-environment configurations of a resource.
+// The API token must have the "Resources > Read" scope.
+func (c *Client) ListResourceConfigurations(ctx context.Context, resourceID string) ([]ResourceConfiguration, error) {
+	baseURL := fmt.Sprintf("%s/resources/%s/configurations", c.BaseURL, resourceID)
+	return doPaginatedRequest[ResourceConfiguration](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllResourceConfigurations returns an iterator over the per-environment configurations of a resource, fetching
+// pages lazily as the iteration progresses. The API token must have the "Resources > Read" scope.
+func (c *Client) AllResourceConfigurations(ctx context.Context, resourceID string) iter.Seq2[ResourceConfiguration, error] {
+	return paginateItems(c.ResourceConfigurationPages(ctx, resourceID, ""))
+}
+
+// ResourceConfigurationPages returns an iterator over the pages of configurations of a resource, starting at the page
+// identified by next (or the first page when empty). The API token must have the "Resources > Read" scope.
+func (c *Client) ResourceConfigurationPages(ctx context.Context, resourceID, next string) iter.Seq2[*Page[ResourceConfiguration], error] {
+	baseURL := fmt.Sprintf("%s/resources/%s/configurations", c.BaseURL, resourceID)
+	return paginatePages[ResourceConfiguration](ctx, c, "GET", baseURL, nil, url.Values{}, next)
+}
+
+// GetResourceConfiguration returns a configuration of a resource. The API token must have the "Resources > Read" scope.
+func (c *Client) GetResourceConfiguration(ctx context.Context, resourceID, configurationID string) (*ResourceConfiguration, error) {
+	baseURL := fmt.Sprintf("%s/resources/%s/configurations/%s", c.BaseURL, resourceID, configurationID)
+	return doSingleRequest[ResourceConfiguration](ctx, c, "GET", baseURL, nil)
+}
+
+// resourceConfigurationRequest encodes the request body for creating and updating resource configurations.
+func resourceConfigurationRequest(environmentID string, options ResourceOptions) ([]byte, error) {
+	if environmentID == "" {
+		return nil, errors.New("environment id cannot be empty")
+	}
+
+	if options == nil {
+		return nil, errors.New("resource options cannot be nil")
+	}
+
+	if err := options.Validate(); err != nil {
+		return nil, fmt.Errorf("validating %s options: %w", options.ResourceType(), err)
+	}
+
+	requestBody := struct {
+		EnvironmentID string          `json:"environment_id"`
+		Options       ResourceOptions `json:"options"`
+	}{
+		EnvironmentID: environmentID,
+		Options:       options,
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	return requestBodyJSON, nil
+}
+
+// CreateResourceConfiguration creates the configuration of a resource for an environment and returns it.
+// The API token must have the "Resources > Write" scope.
+func (c *Client) CreateResourceConfiguration(ctx context.Context, resourceID, environmentID string, options ResourceOptions) (*ResourceConfiguration, error) {
+	requestBodyJSON, err := resourceConfigurationRequest(environmentID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/resources/%s/configurations", c.BaseURL, resourceID)
+	return doSingleRequest[ResourceConfiguration](ctx, c, "POST", baseURL, requestBodyJSON)
+}
+
+// UpdateResourceConfiguration replaces the options of a configuration of a resource and returns the updated
+// configuration. The API token must have the "Resources > Write" scope.
+func (c *Client) UpdateResourceConfiguration(ctx context.Context, resourceID, configurationID, environmentID string, options ResourceOptions) (*ResourceConfiguration, error) {
+	requestBodyJSON, err := resourceConfigurationRequest(environmentID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/resources/%s/configurations/%s", c.BaseURL, resourceID, configurationID)
+	return doSingleRequest[ResourceConfiguration](ctx, c, "PUT", baseURL, requestBodyJSON)
+}
+
+// DeleteResourceConfiguration deletes a configuration of a resource. The API token must have the "Resources > Write" scope.
+func (c *Client) DeleteResourceConfiguration(ctx context.Context, resourceID, configurationID string) error {
+	baseURL := fmt.Sprintf("%s/resources/%s/configurations/%s", c.BaseURL, resourceID, configurationID)
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
+	return err
+}
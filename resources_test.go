@@ -0,0 +1,202 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetResource_TypedOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/resources/resource_123", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "resource_123", "type": "postgresql", "display_name": "Warehouse", "options": {"host": "db.example.com", "port": 5432, "database_name": "warehouse", "database_username": "retool", "database_password": "db-secret", "ssl_enabled": true}}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	resource, err := client.GetResource(context.Background(), "resource_123")
+	assert.NoError(t, err)
+	assert.Equal(t, "Warehouse", resource.DisplayName)
+
+	options, err := resource.TypedOptions()
+	assert.NoError(t, err)
+	assert.Equal(t, retool.PostgreSQLOptions{
+		Host:             "db.example.com",
+		Port:             5432,
+		DatabaseName:     "warehouse",
+		DatabaseUsername: "retool",
+		DatabasePassword: "db-secret",
+		SSLEnabled:       true,
+	}, options)
+
+	assert.NotContains(t, fmt.Sprint(resource), "db-secret")
+	assert.NotContains(t, fmt.Sprintf("%+v %#v", resource, *resource), "db-secret")
+}
+
+func TestListResources_Filters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/resources", r.URL.Path)
+		assert.Equal(t, "s3", r.URL.Query().Get("type"))
+		assert.Equal(t, "folder_123", r.URL.Query().Get("folder_id"))
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "resource_1", "type": "s3"}], "total_count": 1, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	resources, err := client.ListResources(context.Background(), &retool.ListResourcesOpts{Type: retool.ResourceTypeS3, FolderID: "folder_123"})
+	assert.NoError(t, err)
+	assert.Len(t, resources, 1)
+}
+
+func TestCreateResource_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/resources", r.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "s3", body["type"])
+		assert.Equal(t, "Uploads", body["display_name"])
+		assert.Equal(t, map[string]interface{}{
+			"bucket_name":       "uploads",
+			"region":            "eu-west-1",
+			"access_key_id":     "AKIA123",
+			"secret_access_key": "s3-secret",
+		}, body["options"])
+
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "resource_123", "type": "s3", "display_name": "Uploads"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	resource, err := client.CreateResource(context.Background(), "Uploads", "", retool.S3Options{
+		BucketName:      "uploads",
+		Region:          "eu-west-1",
+		AccessKeyID:     "AKIA123",
+		SecretAccessKey: "s3-secret",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "resource_123", resource.ID)
+}
+
+func TestCreateResource_ValidationFailure(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://example.com")
+	assert.NoError(t, err)
+
+	_, err = client.CreateResource(context.Background(), "Warehouse", "", retool.MySQLOptions{DatabaseName: "warehouse"})
+	assert.EqualError(t, err, "validating mysql options: host is required")
+
+	_, err = client.CreateResource(context.Background(), "", "", retool.MySQLOptions{Host: "db", DatabaseName: "warehouse"})
+	assert.EqualError(t, err, "display name cannot be empty")
+
+	_, err = client.CreateResource(context.Background(), "Warehouse", "", nil)
+	assert.EqualError(t, err, "resource options cannot be nil")
+}
+
+func TestUpdateResource_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/api/v2/resources/resource_123", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "resource_123", "type": "graphql", "display_name": "Graph"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	resource, err := client.UpdateResource(context.Background(), "resource_123", "Graph", "", retool.GraphQLOptions{BaseURL: "https://graph.example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Graph", resource.DisplayName)
+}
+
+func TestDeleteResource_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.DeleteResource(context.Background(), "resource_123"))
+}
+
+func TestResourceConfigurations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/resources/resource_123/configurations":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "config_1", "resource_id": "resource_123", "environment_id": "env_prod"}], "total_count": 1, "has_more": false}`)
+		case "POST /api/v2/resources/resource_123/configurations":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "env_staging", body["environment_id"])
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "config_2", "resource_id": "resource_123", "environment_id": "env_staging"}}`)
+		case "PUT /api/v2/resources/resource_123/configurations/config_2":
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "config_2", "resource_id": "resource_123", "environment_id": "env_staging"}}`)
+		case "DELETE /api/v2/resources/resource_123/configurations/config_2":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	configurations, err := client.ListResourceConfigurations(ctx, "resource_123")
+	assert.NoError(t, err)
+	assert.Equal(t, "env_prod", configurations[0].EnvironmentID)
+
+	options := retool.PostgreSQLOptions{Host: "staging-db", DatabaseName: "warehouse", DatabasePassword: "staging-secret"}
+
+	configuration, err := client.CreateResourceConfiguration(ctx, "resource_123", "env_staging", options)
+	assert.NoError(t, err)
+	assert.Equal(t, "config_2", configuration.ID)
+
+	_, err = client.UpdateResourceConfiguration(ctx, "resource_123", "config_2", "env_staging", options)
+	assert.NoError(t, err)
+
+	_, err = client.CreateResourceConfiguration(ctx, "resource_123", "", options)
+	assert.EqualError(t, err, "environment id cannot be empty")
+
+	assert.NoError(t, client.DeleteResourceConfiguration(ctx, "resource_123", "config_2"))
+}
+
+func TestAllResourceConfigurations_Pages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/resources/resource_123/configurations", r.URL.Path)
+		switch r.URL.Query().Get("next") {
+		case "":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "config_1", "environment_id": "env_prod"}], "total_count": 2, "has_more": true, "next_token": "page2"}`)
+		case "page2":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "config_2", "environment_id": "env_staging"}], "total_count": 2, "has_more": false}`)
+		}
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	var environments []string
+	for configuration, err := range client.AllResourceConfigurations(context.Background(), "resource_123") {
+		assert.NoError(t, err)
+		environments = append(environments, configuration.EnvironmentID)
+	}
+	assert.Equal(t, []string{"env_prod", "env_staging"}, environments)
+}
@@ -0,0 +1,68 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// ListAccessRequests records the call and returns the result of ListAccessRequestsFunc.
+func (c *Client) ListAccessRequests(ctx context.Context, opts *retool.ListAccessRequestsOpts) ([]retool.AccessRequest, error) {
+	c.record("ListAccessRequests", opts)
+	if c.ListAccessRequestsFunc == nil {
+		return nil, notConfigured("ListAccessRequests")
+	}
+
+	return c.ListAccessRequestsFunc(ctx, opts)
+}
+
+// AllAccessRequests records the call and returns the result of AllAccessRequestsFunc.
+func (c *Client) AllAccessRequests(ctx context.Context, opts *retool.ListAccessRequestsOpts) iter.Seq2[retool.AccessRequest, error] {
+	c.record("AllAccessRequests", opts)
+	if c.AllAccessRequestsFunc == nil {
+		return notConfiguredSeq[retool.AccessRequest]("AllAccessRequests")
+	}
+
+	return c.AllAccessRequestsFunc(ctx, opts)
+}
+
+// AccessRequestPages records the call and returns the result of AccessRequestPagesFunc.
+func (c *Client) AccessRequestPages(ctx context.Context, opts *retool.ListAccessRequestsOpts, next string) iter.Seq2[*retool.Page[retool.AccessRequest], error] {
+	c.record("AccessRequestPages", opts, next)
+	if c.AccessRequestPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.AccessRequest]]("AccessRequestPages")
+	}
+
+	return c.AccessRequestPagesFunc(ctx, opts, next)
+}
+
+// GetAccessRequest records the call and returns the result of GetAccessRequestFunc.
+func (c *Client) GetAccessRequest(ctx context.Context, id string) (*retool.AccessRequest, error) {
+	c.record("GetAccessRequest", id)
+	if c.GetAccessRequestFunc == nil {
+		return nil, notConfigured("GetAccessRequest")
+	}
+
+	return c.GetAccessRequestFunc(ctx, id)
+}
+
+// ApproveAccessRequest records the call and returns the result of ApproveAccessRequestFunc.
+func (c *Client) ApproveAccessRequest(ctx context.Context, id string, accessLevel retool.AccessLevel) (*retool.AccessRequest, error) {
+	c.record("ApproveAccessRequest", id, accessLevel)
+	if c.ApproveAccessRequestFunc == nil {
+		return nil, notConfigured("ApproveAccessRequest")
+	}
+
+	return c.ApproveAccessRequestFunc(ctx, id, accessLevel)
+}
+
+// DenyAccessRequest records the call and returns the result of DenyAccessRequestFunc.
+func (c *Client) DenyAccessRequest(ctx context.Context, id, reason string) (*retool.AccessRequest, error) {
+	c.record("DenyAccessRequest", id, reason)
+	if c.DenyAccessRequestFunc == nil {
+		return nil, notConfigured("DenyAccessRequest")
+	}
+
+	return c.DenyAccessRequestFunc(ctx, id, reason)
+}
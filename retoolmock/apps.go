@@ -0,0 +1,68 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetApp records the call and returns the result of GetAppFunc.
+func (c *Client) GetApp(ctx context.Context, id string) (*retool.App, error) {
+	c.record("GetApp", id)
+	if c.GetAppFunc == nil {
+		return nil, notConfigured("GetApp")
+	}
+
+	return c.GetAppFunc(ctx, id)
+}
+
+// ListApps records the call and returns the result of ListAppsFunc.
+func (c *Client) ListApps(ctx context.Context, opts *retool.ListAppsOpts) ([]retool.App, error) {
+	c.record("ListApps", opts)
+	if c.ListAppsFunc == nil {
+		return nil, notConfigured("ListApps")
+	}
+
+	return c.ListAppsFunc(ctx, opts)
+}
+
+// AllApps records the call and returns the result of AllAppsFunc.
+func (c *Client) AllApps(ctx context.Context, opts *retool.ListAppsOpts) iter.Seq2[retool.App, error] {
+	c.record("AllApps", opts)
+	if c.AllAppsFunc == nil {
+		return notConfiguredSeq[retool.App]("AllApps")
+	}
+
+	return c.AllAppsFunc(ctx, opts)
+}
+
+// AppPages records the call and returns the result of AppPagesFunc.
+func (c *Client) AppPages(ctx context.Context, opts *retool.ListAppsOpts, next string) iter.Seq2[*retool.Page[retool.App], error] {
+	c.record("AppPages", opts, next)
+	if c.AppPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.App]]("AppPages")
+	}
+
+	return c.AppPagesFunc(ctx, opts, next)
+}
+
+// MoveAppToFolder records the call and returns the result of MoveAppToFolderFunc.
+func (c *Client) MoveAppToFolder(ctx context.Context, id, folderID string) (*retool.App, error) {
+	c.record("MoveAppToFolder", id, folderID)
+	if c.MoveAppToFolderFunc == nil {
+		return nil, notConfigured("MoveAppToFolder")
+	}
+
+	return c.MoveAppToFolderFunc(ctx, id, folderID)
+}
+
+// DeleteApp records the call and returns the result of DeleteAppFunc.
+func (c *Client) DeleteApp(ctx context.Context, id string) error {
+	c.record("DeleteApp", id)
+	if c.DeleteAppFunc == nil {
+		return notConfigured("DeleteApp")
+	}
+
+	return c.DeleteAppFunc(ctx, id)
+}
@@ -0,0 +1,49 @@
+package retoolmock
+
+import (
+	"context"
+	"io"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// ListAuditLogs records the call and returns the result of ListAuditLogsFunc.
+func (c *Client) ListAuditLogs(ctx context.Context, opts *retool.ListAuditLogsOpts) ([]retool.AuditLog, error) {
+	c.record("ListAuditLogs", opts)
+	if c.ListAuditLogsFunc == nil {
+		return nil, notConfigured("ListAuditLogs")
+	}
+
+	return c.ListAuditLogsFunc(ctx, opts)
+}
+
+// AllAuditLogs records the call and returns the result of AllAuditLogsFunc.
+func (c *Client) AllAuditLogs(ctx context.Context, opts *retool.ListAuditLogsOpts) iter.Seq2[retool.AuditLog, error] {
+	c.record("AllAuditLogs", opts)
+	if c.AllAuditLogsFunc == nil {
+		return notConfiguredSeq[retool.AuditLog]("AllAuditLogs")
+	}
+
+	return c.AllAuditLogsFunc(ctx, opts)
+}
+
+// AuditLogPages records the call and returns the result of AuditLogPagesFunc.
+func (c *Client) AuditLogPages(ctx context.Context, opts *retool.ListAuditLogsOpts, next string) iter.Seq2[*retool.Page[retool.AuditLog], error] {
+	c.record("AuditLogPages", opts, next)
+	if c.AuditLogPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.AuditLog]]("AuditLogPages")
+	}
+
+	return c.AuditLogPagesFunc(ctx, opts, next)
+}
+
+// ExportAuditLogs records the call and returns the result of ExportAuditLogsFunc.
+func (c *Client) ExportAuditLogs(ctx context.Context, w io.Writer, format string, opts *retool.ListAuditLogsOpts) (int, error) {
+	c.record("ExportAuditLogs", w, format, opts)
+	if c.ExportAuditLogsFunc == nil {
+		return 0, notConfigured("ExportAuditLogs")
+	}
+
+	return c.ExportAuditLogsFunc(ctx, w, format, opts)
+}
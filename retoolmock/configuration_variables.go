@@ -0,0 +1,78 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetConfigurationVariable records the call and returns the result of GetConfigurationVariableFunc.
+func (c *Client) GetConfigurationVariable(ctx context.Context, id string) (*retool.ConfigurationVariable, error) {
+	c.record("GetConfigurationVariable", id)
+	if c.GetConfigurationVariableFunc == nil {
+		return nil, notConfigured("GetConfigurationVariable")
+	}
+
+	return c.GetConfigurationVariableFunc(ctx, id)
+}
+
+// ListConfigurationVariables records the call and returns the result of ListConfigurationVariablesFunc.
+func (c *Client) ListConfigurationVariables(ctx context.Context) ([]retool.ConfigurationVariable, error) {
+	c.record("ListConfigurationVariables")
+	if c.ListConfigurationVariablesFunc == nil {
+		return nil, notConfigured("ListConfigurationVariables")
+	}
+
+	return c.ListConfigurationVariablesFunc(ctx)
+}
+
+// AllConfigurationVariables records the call and returns the result of AllConfigurationVariablesFunc.
+func (c *Client) AllConfigurationVariables(ctx context.Context) iter.Seq2[retool.ConfigurationVariable, error] {
+	c.record("AllConfigurationVariables")
+	if c.AllConfigurationVariablesFunc == nil {
+		return notConfiguredSeq[retool.ConfigurationVariable]("AllConfigurationVariables")
+	}
+
+	return c.AllConfigurationVariablesFunc(ctx)
+}
+
+// ConfigurationVariablePages records the call and returns the result of ConfigurationVariablePagesFunc.
+func (c *Client) ConfigurationVariablePages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.ConfigurationVariable], error] {
+	c.record("ConfigurationVariablePages", next)
+	if c.ConfigurationVariablePagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.ConfigurationVariable]]("ConfigurationVariablePages")
+	}
+
+	return c.ConfigurationVariablePagesFunc(ctx, next)
+}
+
+// CreateConfigurationVariable records the call and returns the result of CreateConfigurationVariableFunc.
+func (c *Client) CreateConfigurationVariable(ctx context.Context, name, description string, secret bool, values []retool.Value) (*retool.ConfigurationVariable, error) {
+	c.record("CreateConfigurationVariable", name, description, secret, values)
+	if c.CreateConfigurationVariableFunc == nil {
+		return nil, notConfigured("CreateConfigurationVariable")
+	}
+
+	return c.CreateConfigurationVariableFunc(ctx, name, description, secret, values)
+}
+
+// UpdateConfigurationVariable records the call and returns the result of UpdateConfigurationVariableFunc.
+func (c *Client) UpdateConfigurationVariable(ctx context.Context, id, name, description string, secret bool, values []retool.Value) (*retool.ConfigurationVariable, error) {
+	c.record("UpdateConfigurationVariable", id, name, description, secret, values)
+	if c.UpdateConfigurationVariableFunc == nil {
+		return nil, notConfigured("UpdateConfigurationVariable")
+	}
+
+	return c.UpdateConfigurationVariableFunc(ctx, id, name, description, secret, values)
+}
+
+// DeleteConfigurationVariable records the call and returns the result of DeleteConfigurationVariableFunc.
+func (c *Client) DeleteConfigurationVariable(ctx context.Context, id string) error {
+	c.record("DeleteConfigurationVariable", id)
+	if c.DeleteConfigurationVariableFunc == nil {
+		return notConfigured("DeleteConfigurationVariable")
+	}
+
+	return c.DeleteConfigurationVariableFunc(ctx, id)
+}
@@ -0,0 +1,89 @@
+package retoolmock
+
+import (
+	"context"
+	"io"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// ListCustomComponentLibraries records the call and returns the result of ListCustomComponentLibrariesFunc.
+func (c *Client) ListCustomComponentLibraries(ctx context.Context) ([]retool.CustomComponentLibrary, error) {
+	c.record("ListCustomComponentLibraries")
+	if c.ListCustomComponentLibrariesFunc == nil {
+		return nil, notConfigured("ListCustomComponentLibraries")
+	}
+
+	return c.ListCustomComponentLibrariesFunc(ctx)
+}
+
+// AllCustomComponentLibraries records the call and returns the result of AllCustomComponentLibrariesFunc.
+func (c *Client) AllCustomComponentLibraries(ctx context.Context) iter.Seq2[retool.CustomComponentLibrary, error] {
+	c.record("AllCustomComponentLibraries")
+	if c.AllCustomComponentLibrariesFunc == nil {
+		return notConfiguredSeq[retool.CustomComponentLibrary]("AllCustomComponentLibraries")
+	}
+
+	return c.AllCustomComponentLibrariesFunc(ctx)
+}
+
+// CustomComponentLibraryPages records the call and returns the result of CustomComponentLibraryPagesFunc.
+func (c *Client) CustomComponentLibraryPages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.CustomComponentLibrary], error] {
+	c.record("CustomComponentLibraryPages", next)
+	if c.CustomComponentLibraryPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.CustomComponentLibrary]]("CustomComponentLibraryPages")
+	}
+
+	return c.CustomComponentLibraryPagesFunc(ctx, next)
+}
+
+// CreateCustomComponentLibrary records the call and returns the result of CreateCustomComponentLibraryFunc.
+func (c *Client) CreateCustomComponentLibrary(ctx context.Context, name, label, description string) (*retool.CustomComponentLibrary, error) {
+	c.record("CreateCustomComponentLibrary", name, label, description)
+	if c.CreateCustomComponentLibraryFunc == nil {
+		return nil, notConfigured("CreateCustomComponentLibrary")
+	}
+
+	return c.CreateCustomComponentLibraryFunc(ctx, name, label, description)
+}
+
+// ListLibraryRevisions records the call and returns the result of ListLibraryRevisionsFunc.
+func (c *Client) ListLibraryRevisions(ctx context.Context, libraryID string) ([]retool.LibraryRevision, error) {
+	c.record("ListLibraryRevisions", libraryID)
+	if c.ListLibraryRevisionsFunc == nil {
+		return nil, notConfigured("ListLibraryRevisions")
+	}
+
+	return c.ListLibraryRevisionsFunc(ctx, libraryID)
+}
+
+// AllLibraryRevisions records the call and returns the result of AllLibraryRevisionsFunc.
+func (c *Client) AllLibraryRevisions(ctx context.Context, libraryID string) iter.Seq2[retool.LibraryRevision, error] {
+	c.record("AllLibraryRevisions", libraryID)
+	if c.AllLibraryRevisionsFunc == nil {
+		return notConfiguredSeq[retool.LibraryRevision]("AllLibraryRevisions")
+	}
+
+	return c.AllLibraryRevisionsFunc(ctx, libraryID)
+}
+
+// LibraryRevisionPages records the call and returns the result of LibraryRevisionPagesFunc.
+func (c *Client) LibraryRevisionPages(ctx context.Context, libraryID, next string) iter.Seq2[*retool.Page[retool.LibraryRevision], error] {
+	c.record("LibraryRevisionPages", libraryID, next)
+	if c.LibraryRevisionPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.LibraryRevision]]("LibraryRevisionPages")
+	}
+
+	return c.LibraryRevisionPagesFunc(ctx, libraryID, next)
+}
+
+// UploadLibraryRevision records the call and returns the result of UploadLibraryRevisionFunc.
+func (c *Client) UploadLibraryRevision(ctx context.Context, libraryID string, bundle io.Reader) (*retool.LibraryRevision, error) {
+	c.record("UploadLibraryRevision", libraryID, bundle)
+	if c.UploadLibraryRevisionFunc == nil {
+		return nil, notConfigured("UploadLibraryRevision")
+	}
+
+	return c.UploadLibraryRevisionFunc(ctx, libraryID, bundle)
+}
@@ -0,0 +1,17 @@
+package retoolmock
+
+import (
+	"context"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// CreateEmbedURL records the call and returns the result of CreateEmbedURLFunc.
+func (c *Client) CreateEmbedURL(ctx context.Context, request *retool.EmbedURLRequest) (*retool.EmbedURL, error) {
+	c.record("CreateEmbedURL", request)
+	if c.CreateEmbedURLFunc == nil {
+		return nil, notConfigured("CreateEmbedURL")
+	}
+
+	return c.CreateEmbedURLFunc(ctx, request)
+}
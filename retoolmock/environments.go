@@ -0,0 +1,88 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetEnvironment records the call and returns the result of GetEnvironmentFunc.
+func (c *Client) GetEnvironment(ctx context.Context, id string) (*retool.Environment, error) {
+	c.record("GetEnvironment", id)
+	if c.GetEnvironmentFunc == nil {
+		return nil, notConfigured("GetEnvironment")
+	}
+
+	return c.GetEnvironmentFunc(ctx, id)
+}
+
+// ListEnvironments records the call and returns the result of ListEnvironmentsFunc.
+func (c *Client) ListEnvironments(ctx context.Context) ([]retool.Environment, error) {
+	c.record("ListEnvironments")
+	if c.ListEnvironmentsFunc == nil {
+		return nil, notConfigured("ListEnvironments")
+	}
+
+	return c.ListEnvironmentsFunc(ctx)
+}
+
+// AllEnvironments records the call and returns the result of AllEnvironmentsFunc.
+func (c *Client) AllEnvironments(ctx context.Context) iter.Seq2[retool.Environment, error] {
+	c.record("AllEnvironments")
+	if c.AllEnvironmentsFunc == nil {
+		return notConfiguredSeq[retool.Environment]("AllEnvironments")
+	}
+
+	return c.AllEnvironmentsFunc(ctx)
+}
+
+// EnvironmentPages records the call and returns the result of EnvironmentPagesFunc.
+func (c *Client) EnvironmentPages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Environment], error] {
+	c.record("EnvironmentPages", next)
+	if c.EnvironmentPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.Environment]]("EnvironmentPages")
+	}
+
+	return c.EnvironmentPagesFunc(ctx, next)
+}
+
+// CreateEnvironment records the call and returns the result of CreateEnvironmentFunc.
+func (c *Client) CreateEnvironment(ctx context.Context, name, description, color string) (*retool.Environment, error) {
+	c.record("CreateEnvironment", name, description, color)
+	if c.CreateEnvironmentFunc == nil {
+		return nil, notConfigured("CreateEnvironment")
+	}
+
+	return c.CreateEnvironmentFunc(ctx, name, description, color)
+}
+
+// UpdateEnvironment records the call and returns the result of UpdateEnvironmentFunc.
+func (c *Client) UpdateEnvironment(ctx context.Context, id string, operations []retool.UpdateOperations) (*retool.Environment, error) {
+	c.record("UpdateEnvironment", id, operations)
+	if c.UpdateEnvironmentFunc == nil {
+		return nil, notConfigured("UpdateEnvironment")
+	}
+
+	return c.UpdateEnvironmentFunc(ctx, id, operations)
+}
+
+// DeleteEnvironment records the call and returns the result of DeleteEnvironmentFunc.
+func (c *Client) DeleteEnvironment(ctx context.Context, id string) error {
+	c.record("DeleteEnvironment", id)
+	if c.DeleteEnvironmentFunc == nil {
+		return notConfigured("DeleteEnvironment")
+	}
+
+	return c.DeleteEnvironmentFunc(ctx, id)
+}
+
+// EnvironmentID records the call and returns the result of EnvironmentIDFunc.
+func (c *Client) EnvironmentID(ctx context.Context, name string) (string, error) {
+	c.record("EnvironmentID", name)
+	if c.EnvironmentIDFunc == nil {
+		return "", notConfigured("EnvironmentID")
+	}
+
+	return c.EnvironmentIDFunc(ctx, name)
+}
@@ -0,0 +1,78 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetFolder records the call and returns the result of GetFolderFunc.
+func (c *Client) GetFolder(ctx context.Context, id string) (*retool.Folder, error) {
+	c.record("GetFolder", id)
+	if c.GetFolderFunc == nil {
+		return nil, notConfigured("GetFolder")
+	}
+
+	return c.GetFolderFunc(ctx, id)
+}
+
+// ListFolders records the call and returns the result of ListFoldersFunc.
+func (c *Client) ListFolders(ctx context.Context) ([]retool.Folder, error) {
+	c.record("ListFolders")
+	if c.ListFoldersFunc == nil {
+		return nil, notConfigured("ListFolders")
+	}
+
+	return c.ListFoldersFunc(ctx)
+}
+
+// AllFolders records the call and returns the result of AllFoldersFunc.
+func (c *Client) AllFolders(ctx context.Context) iter.Seq2[retool.Folder, error] {
+	c.record("AllFolders")
+	if c.AllFoldersFunc == nil {
+		return notConfiguredSeq[retool.Folder]("AllFolders")
+	}
+
+	return c.AllFoldersFunc(ctx)
+}
+
+// FolderPages records the call and returns the result of FolderPagesFunc.
+func (c *Client) FolderPages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Folder], error] {
+	c.record("FolderPages", next)
+	if c.FolderPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.Folder]]("FolderPages")
+	}
+
+	return c.FolderPagesFunc(ctx, next)
+}
+
+// CreateFolder records the call and returns the result of CreateFolderFunc.
+func (c *Client) CreateFolder(ctx context.Context, name, parentFolderID string, folderType retool.FolderType) (*retool.Folder, error) {
+	c.record("CreateFolder", name, parentFolderID, folderType)
+	if c.CreateFolderFunc == nil {
+		return nil, notConfigured("CreateFolder")
+	}
+
+	return c.CreateFolderFunc(ctx, name, parentFolderID, folderType)
+}
+
+// UpdateFolder records the call and returns the result of UpdateFolderFunc.
+func (c *Client) UpdateFolder(ctx context.Context, id string, operations []retool.UpdateOperations) (*retool.Folder, error) {
+	c.record("UpdateFolder", id, operations)
+	if c.UpdateFolderFunc == nil {
+		return nil, notConfigured("UpdateFolder")
+	}
+
+	return c.UpdateFolderFunc(ctx, id, operations)
+}
+
+// DeleteFolder records the call and returns the result of DeleteFolderFunc.
+func (c *Client) DeleteFolder(ctx context.Context, id string) error {
+	c.record("DeleteFolder", id)
+	if c.DeleteFolderFunc == nil {
+		return notConfigured("DeleteFolder")
+	}
+
+	return c.DeleteFolderFunc(ctx, id)
+}
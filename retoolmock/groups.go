@@ -0,0 +1,98 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetGroup records the call and returns the result of GetGroupFunc.
+func (c *Client) GetGroup(ctx context.Context, id string) (*retool.Group, error) {
+	c.record("GetGroup", id)
+	if c.GetGroupFunc == nil {
+		return nil, notConfigured("GetGroup")
+	}
+
+	return c.GetGroupFunc(ctx, id)
+}
+
+// ListGroups records the call and returns the result of ListGroupsFunc.
+func (c *Client) ListGroups(ctx context.Context) ([]retool.Group, error) {
+	c.record("ListGroups")
+	if c.ListGroupsFunc == nil {
+		return nil, notConfigured("ListGroups")
+	}
+
+	return c.ListGroupsFunc(ctx)
+}
+
+// AllGroups records the call and returns the result of AllGroupsFunc.
+func (c *Client) AllGroups(ctx context.Context) iter.Seq2[retool.Group, error] {
+	c.record("AllGroups")
+	if c.AllGroupsFunc == nil {
+		return notConfiguredSeq[retool.Group]("AllGroups")
+	}
+
+	return c.AllGroupsFunc(ctx)
+}
+
+// GroupPages records the call and returns the result of GroupPagesFunc.
+func (c *Client) GroupPages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Group], error] {
+	c.record("GroupPages", next)
+	if c.GroupPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.Group]]("GroupPages")
+	}
+
+	return c.GroupPagesFunc(ctx, next)
+}
+
+// CreateGroup records the call and returns the result of CreateGroupFunc.
+func (c *Client) CreateGroup(ctx context.Context, group *retool.Group) (*retool.Group, error) {
+	c.record("CreateGroup", group)
+	if c.CreateGroupFunc == nil {
+		return nil, notConfigured("CreateGroup")
+	}
+
+	return c.CreateGroupFunc(ctx, group)
+}
+
+// UpdateGroup records the call and returns the result of UpdateGroupFunc.
+func (c *Client) UpdateGroup(ctx context.Context, id string, operations []retool.UpdateOperations) (*retool.Group, error) {
+	c.record("UpdateGroup", id, operations)
+	if c.UpdateGroupFunc == nil {
+		return nil, notConfigured("UpdateGroup")
+	}
+
+	return c.UpdateGroupFunc(ctx, id, operations)
+}
+
+// DeleteGroup records the call and returns the result of DeleteGroupFunc.
+func (c *Client) DeleteGroup(ctx context.Context, id string) error {
+	c.record("DeleteGroup", id)
+	if c.DeleteGroupFunc == nil {
+		return notConfigured("DeleteGroup")
+	}
+
+	return c.DeleteGroupFunc(ctx, id)
+}
+
+// AddUsersToGroup records the call and returns the result of AddUsersToGroupFunc.
+func (c *Client) AddUsersToGroup(ctx context.Context, groupID string, members []retool.Member) (*retool.Group, error) {
+	c.record("AddUsersToGroup", groupID, members)
+	if c.AddUsersToGroupFunc == nil {
+		return nil, notConfigured("AddUsersToGroup")
+	}
+
+	return c.AddUsersToGroupFunc(ctx, groupID, members)
+}
+
+// RemoveUserFromGroup records the call and returns the result of RemoveUserFromGroupFunc.
+func (c *Client) RemoveUserFromGroup(ctx context.Context, groupID, userID string) (*retool.Group, error) {
+	c.record("RemoveUserFromGroup", groupID, userID)
+	if c.RemoveUserFromGroupFunc == nil {
+		return nil, notConfigured("RemoveUserFromGroup")
+	}
+
+	return c.RemoveUserFromGroupFunc(ctx, groupID, userID)
+}
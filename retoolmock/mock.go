@@ -0,0 +1,306 @@
+// Package retoolmock provides a hand-maintained mock of the retoolsdk API interfaces.
+//
+// Every method of Client records its call and delegates to the matching Func field, so tests can program responses
+// per method and assert on the calls made:
+//
+//	mock := &retoolmock.Client{
+//		GetUserFunc: func(ctx context.Context, id string) (*retoolsdk.User, error) {
+//			return &retoolsdk.User{ID: id}, nil
+//		},
+//	}
+//
+// Methods whose Func field is nil return an error wrapping ErrNotConfigured.
+package retoolmock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"sync"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// ErrNotConfigured is returned by methods whose Func field has not been set.
+var ErrNotConfigured = errors.New("retoolmock: method not configured")
+
+// Call is a recorded call to a Client method. Args holds the arguments after the context, in order.
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// Client is a mock implementation of retoolsdk.API. It is safe for concurrent use as long as the Func fields are
+// not changed while it is in use.
+type Client struct {
+	mu    sync.Mutex
+	calls []Call
+
+	// retoolsdk.UsersAPI
+	GetUserFunc                    func(ctx context.Context, id string) (*retool.User, error)
+	ListUsersFunc                  func(ctx context.Context, opts *retool.ListUserOpts) ([]retool.User, error)
+	AllUsersFunc                   func(ctx context.Context, opts *retool.ListUserOpts) iter.Seq2[retool.User, error]
+	UserPagesFunc                  func(ctx context.Context, opts *retool.ListUserOpts, next string) iter.Seq2[*retool.Page[retool.User], error]
+	CreateUserFunc                 func(ctx context.Context, email, firstName, lastName string, opts *retool.CreateUserOpts) (*retool.User, error)
+	UpdateUserFunc                 func(ctx context.Context, id string, operations []retool.UpdateOperations) (*retool.User, error)
+	DeleteUserFunc                 func(ctx context.Context, id string) error
+	UpdateUserAttributesFunc       func(ctx context.Context, id string, attributes []retool.UserAttribute) (map[string]interface{}, error)
+	DeleteUserAttributeFunc        func(ctx context.Context, id, attribute string) (interface{}, error)
+	GetOrganizationAttributesFunc  func(ctx context.Context) ([]retool.OrganizationAttribute, error)
+	AllOrganizationAttributesFunc  func(ctx context.Context) iter.Seq2[retool.OrganizationAttribute, error]
+	OrganizationAttributePagesFunc func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.OrganizationAttribute], error]
+
+	// retoolsdk.GroupsAPI
+	GetGroupFunc            func(ctx context.Context, id string) (*retool.Group, error)
+	ListGroupsFunc          func(ctx context.Context) ([]retool.Group, error)
+	AllGroupsFunc           func(ctx context.Context) iter.Seq2[retool.Group, error]
+	GroupPagesFunc          func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Group], error]
+	CreateGroupFunc         func(ctx context.Context, group *retool.Group) (*retool.Group, error)
+	UpdateGroupFunc         func(ctx context.Context, id string, operations []retool.UpdateOperations) (*retool.Group, error)
+	DeleteGroupFunc         func(ctx context.Context, id string) error
+	AddUsersToGroupFunc     func(ctx context.Context, groupID string, members []retool.Member) (*retool.Group, error)
+	RemoveUserFromGroupFunc func(ctx context.Context, groupID, userID string) (*retool.Group, error)
+
+	// retoolsdk.FoldersAPI
+	GetFolderFunc    func(ctx context.Context, id string) (*retool.Folder, error)
+	ListFoldersFunc  func(ctx context.Context) ([]retool.Folder, error)
+	AllFoldersFunc   func(ctx context.Context) iter.Seq2[retool.Folder, error]
+	FolderPagesFunc  func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Folder], error]
+	CreateFolderFunc func(ctx context.Context, name, parentFolderID string, folderType retool.FolderType) (*retool.Folder, error)
+	UpdateFolderFunc func(ctx context.Context, id string, operations []retool.UpdateOperations) (*retool.Folder, error)
+	DeleteFolderFunc func(ctx context.Context, id string) error
+
+	// retoolsdk.PermissionsAPI
+	GetFolderOrAppAccessListFunc   func(ctx context.Context, objectID, objectType retool.ObjectType) (*retool.GroupedData, error)
+	ListGroupObjectPermissionsFunc func(ctx context.Context, subject string, objectType retool.ObjectType, id any) ([]retool.Subject, error)
+	GrantPermissionFunc            func(ctx context.Context, subject string, subjectID any, objectType retool.ObjectType, objectID string, accessLevel retool.AccessLevel) ([]retool.Subject, error)
+	RevokePermissionFunc           func(ctx context.Context, subject string, subjectID any, objectType retool.ObjectType, objectID string) ([]retool.Subject, error)
+
+	// retoolsdk.SpacesAPI
+	GetSpaceFunc    func(ctx context.Context, id string) (*retool.Space, error)
+	ListSpacesFunc  func(ctx context.Context) ([]retool.Space, error)
+	AllSpacesFunc   func(ctx context.Context) iter.Seq2[retool.Space, error]
+	SpacePagesFunc  func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Space], error]
+	CreateSpaceFunc func(ctx context.Context, name, domain string, options *retool.CreateSpaceOptions) (*retool.Space, error)
+	UpdateSpaceFunc func(ctx context.Context, id, name, domain string) (*retool.Space, error)
+	DeleteSpaceFunc func(ctx context.Context, id string) error
+
+	// retoolsdk.ConfigVarsAPI
+	GetConfigurationVariableFunc    func(ctx context.Context, id string) (*retool.ConfigurationVariable, error)
+	ListConfigurationVariablesFunc  func(ctx context.Context) ([]retool.ConfigurationVariable, error)
+	AllConfigurationVariablesFunc   func(ctx context.Context) iter.Seq2[retool.ConfigurationVariable, error]
+	ConfigurationVariablePagesFunc  func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.ConfigurationVariable], error]
+	CreateConfigurationVariableFunc func(ctx context.Context, name, description string, secret bool, values []retool.Value) (*retool.ConfigurationVariable, error)
+	UpdateConfigurationVariableFunc func(ctx context.Context, id, name, description string, secret bool, values []retool.Value) (*retool.ConfigurationVariable, error)
+	DeleteConfigurationVariableFunc func(ctx context.Context, id string) error
+
+	// retoolsdk.AppsAPI
+	GetAppFunc          func(ctx context.Context, id string) (*retool.App, error)
+	ListAppsFunc        func(ctx context.Context, opts *retool.ListAppsOpts) ([]retool.App, error)
+	AllAppsFunc         func(ctx context.Context, opts *retool.ListAppsOpts) iter.Seq2[retool.App, error]
+	AppPagesFunc        func(ctx context.Context, opts *retool.ListAppsOpts, next string) iter.Seq2[*retool.Page[retool.App], error]
+	MoveAppToFolderFunc func(ctx context.Context, id, folderID string) (*retool.App, error)
+	DeleteAppFunc       func(ctx context.Context, id string) error
+
+	// retoolsdk.WorkflowsAPI
+	GetWorkflowFunc       func(ctx context.Context, id string) (*retool.Workflow, error)
+	ListWorkflowsFunc     func(ctx context.Context) ([]retool.Workflow, error)
+	AllWorkflowsFunc      func(ctx context.Context) iter.Seq2[retool.Workflow, error]
+	WorkflowPagesFunc     func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Workflow], error]
+	TriggerWorkflowFunc   func(ctx context.Context, id string, payload any, opts *retool.TriggerWorkflowOpts) (*retool.WorkflowRun, error)
+	ListWorkflowRunsFunc  func(ctx context.Context, workflowID string, opts *retool.ListWorkflowRunsOpts) ([]retool.WorkflowRun, error)
+	AllWorkflowRunsFunc   func(ctx context.Context, workflowID string, opts *retool.ListWorkflowRunsOpts) iter.Seq2[retool.WorkflowRun, error]
+	WorkflowRunPagesFunc  func(ctx context.Context, workflowID string, opts *retool.ListWorkflowRunsOpts, next string) iter.Seq2[*retool.Page[retool.WorkflowRun], error]
+	GetWorkflowRunFunc    func(ctx context.Context, workflowID, runID string) (*retool.WorkflowRun, error)
+	CancelWorkflowRunFunc func(ctx context.Context, workflowID, runID string) (*retool.WorkflowRun, error)
+
+	// retoolsdk.ResourcesAPI
+	GetResourceFunc                 func(ctx context.Context, id string) (*retool.Resource, error)
+	ListResourcesFunc               func(ctx context.Context, opts *retool.ListResourcesOpts) ([]retool.Resource, error)
+	AllResourcesFunc                func(ctx context.Context, opts *retool.ListResourcesOpts) iter.Seq2[retool.Resource, error]
+	ResourcePagesFunc               func(ctx context.Context, opts *retool.ListResourcesOpts, next string) iter.Seq2[*retool.Page[retool.Resource], error]
+	CreateResourceFunc              func(ctx context.Context, displayName, folderID string, options retool.ResourceOptions) (*retool.Resource, error)
+	UpdateResourceFunc              func(ctx context.Context, id, displayName, folderID string, options retool.ResourceOptions) (*retool.Resource, error)
+	DeleteResourceFunc              func(ctx context.Context, id string) error
+	ListResourceConfigurationsFunc  func(ctx context.Context, resourceID string) ([]retool.ResourceConfiguration, error)
+	AllResourceConfigurationsFunc   func(ctx context.Context, resourceID string) iter.Seq2[retool.ResourceConfiguration, error]
+	ResourceConfigurationPagesFunc  func(ctx context.Context, resourceID, next string) iter.Seq2[*retool.Page[retool.ResourceConfiguration], error]
+	GetResourceConfigurationFunc    func(ctx context.Context, resourceID, configurationID string) (*retool.ResourceConfiguration, error)
+	CreateResourceConfigurationFunc func(ctx context.Context, resourceID, environmentID string, options retool.ResourceOptions) (*retool.ResourceConfiguration, error)
+	UpdateResourceConfigurationFunc func(ctx context.Context, resourceID, configurationID, environmentID string, options retool.ResourceOptions) (*retool.ResourceConfiguration, error)
+	DeleteResourceConfigurationFunc func(ctx context.Context, resourceID, configurationID string) error
+
+	// retoolsdk.EnvironmentsAPI
+	GetEnvironmentFunc    func(ctx context.Context, id string) (*retool.Environment, error)
+	ListEnvironmentsFunc  func(ctx context.Context) ([]retool.Environment, error)
+	AllEnvironmentsFunc   func(ctx context.Context) iter.Seq2[retool.Environment, error]
+	EnvironmentPagesFunc  func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Environment], error]
+	CreateEnvironmentFunc func(ctx context.Context, name, description, color string) (*retool.Environment, error)
+	UpdateEnvironmentFunc func(ctx context.Context, id string, operations []retool.UpdateOperations) (*retool.Environment, error)
+	DeleteEnvironmentFunc func(ctx context.Context, id string) error
+	EnvironmentIDFunc     func(ctx context.Context, name string) (string, error)
+
+	// retoolsdk.SourceControlAPI
+	GetSourceControlConfigFunc      func(ctx context.Context) (*retool.SourceControlConfig, error)
+	UpdateSourceControlConfigFunc   func(ctx context.Context, config *retool.SourceControlConfig) (*retool.SourceControlConfig, error)
+	GetSourceControlSettingsFunc    func(ctx context.Context) (*retool.SourceControlSettings, error)
+	UpdateSourceControlSettingsFunc func(ctx context.Context, settings retool.SourceControlSettings) (*retool.SourceControlSettings, error)
+	TestSourceControlConnectionFunc func(ctx context.Context, config *retool.SourceControlConfig) (*retool.ConnectionTestResult, error)
+	ListBranchesFunc                func(ctx context.Context) ([]retool.Branch, error)
+	AllBranchesFunc                 func(ctx context.Context) iter.Seq2[retool.Branch, error]
+	BranchPagesFunc                 func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Branch], error]
+	CreateBranchFunc                func(ctx context.Context, name, source string) (*retool.Branch, error)
+	DeleteBranchFunc                func(ctx context.Context, name string) error
+	ListCommitsFunc                 func(ctx context.Context, opts *retool.ListCommitsOpts) ([]retool.Commit, error)
+	AllCommitsFunc                  func(ctx context.Context, opts *retool.ListCommitsOpts) iter.Seq2[retool.Commit, error]
+	CommitPagesFunc                 func(ctx context.Context, opts *retool.ListCommitsOpts, next string) iter.Seq2[*retool.Page[retool.Commit], error]
+	TriggerDeployFunc               func(ctx context.Context, opts *retool.TriggerDeployOpts) (*retool.Deploy, error)
+	ListDeploysFunc                 func(ctx context.Context) ([]retool.Deploy, error)
+	AllDeploysFunc                  func(ctx context.Context) iter.Seq2[retool.Deploy, error]
+	DeployPagesFunc                 func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Deploy], error]
+	GetDeployFunc                   func(ctx context.Context, id string) (*retool.Deploy, error)
+
+	// retoolsdk.UserInvitesAPI
+	CreateUserInviteFunc func(ctx context.Context, email string, opts *retool.CreateUserInviteOpts) (*retool.UserInvite, error)
+	GetUserInviteFunc    func(ctx context.Context, id string) (*retool.UserInvite, error)
+	ListUserInvitesFunc  func(ctx context.Context) ([]retool.UserInvite, error)
+	AllUserInvitesFunc   func(ctx context.Context) iter.Seq2[retool.UserInvite, error]
+	UserInvitePagesFunc  func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.UserInvite], error]
+	RevokeUserInviteFunc func(ctx context.Context, id string) error
+	ResendUserInviteFunc func(ctx context.Context, id string) (*retool.UserInvite, error)
+
+	// retoolsdk.SSOAPI
+	GetSSOConfigFunc    func(ctx context.Context) (retool.SSOConfig, error)
+	SetSSOConfigFunc    func(ctx context.Context, config retool.SSOConfig) (retool.SSOConfig, error)
+	DeleteSSOConfigFunc func(ctx context.Context) error
+
+	// retoolsdk.EmbedAPI
+	CreateEmbedURLFunc func(ctx context.Context, request *retool.EmbedURLRequest) (*retool.EmbedURL, error)
+
+	// retoolsdk.UsageAPI
+	GetUsageFunc           func(ctx context.Context, start, end time.Time, opts *retool.UsageOpts) (*retool.Usage, error)
+	ListAppUsageFunc       func(ctx context.Context, start, end time.Time, opts *retool.UsageOpts) ([]retool.AppUsage, error)
+	AllAppUsageFunc        func(ctx context.Context, start, end time.Time, opts *retool.UsageOpts) iter.Seq2[retool.AppUsage, error]
+	AppUsagePagesFunc      func(ctx context.Context, start, end time.Time, opts *retool.UsageOpts, next string) iter.Seq2[*retool.Page[retool.AppUsage], error]
+	ListUserUsageFunc      func(ctx context.Context, start, end time.Time, opts *retool.UsageOpts) ([]retool.UserUsage, error)
+	AllUserUsageFunc       func(ctx context.Context, start, end time.Time, opts *retool.UsageOpts) iter.Seq2[retool.UserUsage, error]
+	UserUsagePagesFunc     func(ctx context.Context, start, end time.Time, opts *retool.UsageOpts, next string) iter.Seq2[*retool.Page[retool.UserUsage], error]
+	GetSeatUtilizationFunc func(ctx context.Context, start, end time.Time) ([]retool.SeatUtilization, error)
+
+	// retoolsdk.AuditLogsAPI
+	ListAuditLogsFunc   func(ctx context.Context, opts *retool.ListAuditLogsOpts) ([]retool.AuditLog, error)
+	AllAuditLogsFunc    func(ctx context.Context, opts *retool.ListAuditLogsOpts) iter.Seq2[retool.AuditLog, error]
+	AuditLogPagesFunc   func(ctx context.Context, opts *retool.ListAuditLogsOpts, next string) iter.Seq2[*retool.Page[retool.AuditLog], error]
+	ExportAuditLogsFunc func(ctx context.Context, w io.Writer, format string, opts *retool.ListAuditLogsOpts) (int, error)
+
+	// retoolsdk.CustomComponentsAPI
+	ListCustomComponentLibrariesFunc func(ctx context.Context) ([]retool.CustomComponentLibrary, error)
+	AllCustomComponentLibrariesFunc  func(ctx context.Context) iter.Seq2[retool.CustomComponentLibrary, error]
+	CustomComponentLibraryPagesFunc  func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.CustomComponentLibrary], error]
+	CreateCustomComponentLibraryFunc func(ctx context.Context, name, label, description string) (*retool.CustomComponentLibrary, error)
+	ListLibraryRevisionsFunc         func(ctx context.Context, libraryID string) ([]retool.LibraryRevision, error)
+	AllLibraryRevisionsFunc          func(ctx context.Context, libraryID string) iter.Seq2[retool.LibraryRevision, error]
+	LibraryRevisionPagesFunc         func(ctx context.Context, libraryID, next string) iter.Seq2[*retool.Page[retool.LibraryRevision], error]
+	UploadLibraryRevisionFunc        func(ctx context.Context, libraryID string, bundle io.Reader) (*retool.LibraryRevision, error)
+
+	// retoolsdk.ThemesAPI
+	GetThemeFunc         func(ctx context.Context, id string) (*retool.Theme, error)
+	ListThemesFunc       func(ctx context.Context) ([]retool.Theme, error)
+	AllThemesFunc        func(ctx context.Context) iter.Seq2[retool.Theme, error]
+	ThemePagesFunc       func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Theme], error]
+	CreateThemeFunc      func(ctx context.Context, theme *retool.Theme) (*retool.Theme, error)
+	UpdateThemeFunc      func(ctx context.Context, id string, theme *retool.Theme) (*retool.Theme, error)
+	DeleteThemeFunc      func(ctx context.Context, id string) error
+	SetDefaultThemeFunc  func(ctx context.Context, id string) (*retool.Theme, error)
+	CopyThemeToSpaceFunc func(ctx context.Context, id string, child retool.ThemesAPI, opts *retool.CopyThemeOpts) (*retool.Theme, error)
+
+	// retoolsdk.ObservabilityAPI
+	GetObservabilityConfigFunc      func(ctx context.Context, id string) (*retool.ObservabilityConfig, error)
+	ListObservabilityConfigsFunc    func(ctx context.Context) ([]retool.ObservabilityConfig, error)
+	AllObservabilityConfigsFunc     func(ctx context.Context) iter.Seq2[retool.ObservabilityConfig, error]
+	ObservabilityConfigPagesFunc    func(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.ObservabilityConfig], error]
+	CreateObservabilityConfigFunc   func(ctx context.Context, config *retool.ObservabilityConfig) (*retool.ObservabilityConfig, error)
+	UpdateObservabilityConfigFunc   func(ctx context.Context, id string, config *retool.ObservabilityConfig) (*retool.ObservabilityConfig, error)
+	DeleteObservabilityConfigFunc   func(ctx context.Context, id string) error
+	TestObservabilityConnectionFunc func(ctx context.Context, config *retool.ObservabilityConfig) (*retool.ConnectionTestResult, error)
+
+	// retoolsdk.AccessRequestsAPI
+	ListAccessRequestsFunc   func(ctx context.Context, opts *retool.ListAccessRequestsOpts) ([]retool.AccessRequest, error)
+	AllAccessRequestsFunc    func(ctx context.Context, opts *retool.ListAccessRequestsOpts) iter.Seq2[retool.AccessRequest, error]
+	AccessRequestPagesFunc   func(ctx context.Context, opts *retool.ListAccessRequestsOpts, next string) iter.Seq2[*retool.Page[retool.AccessRequest], error]
+	GetAccessRequestFunc     func(ctx context.Context, id string) (*retool.AccessRequest, error)
+	ApproveAccessRequestFunc func(ctx context.Context, id string, accessLevel retool.AccessLevel) (*retool.AccessRequest, error)
+	DenyAccessRequestFunc    func(ctx context.Context, id, reason string) (*retool.AccessRequest, error)
+}
+
+var _ retool.API = (*Client)(nil)
+
+// Calls returns the recorded calls in the order they were made.
+func (c *Client) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Call(nil), c.calls...)
+}
+
+// CallsTo returns the recorded calls to the named method in the order they were made.
+func (c *Client) CallsTo(method string) []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var calls []Call
+	for _, call := range c.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// Reset clears the recorded calls.
+func (c *Client) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = nil
+}
+
+func (c *Client) record(method string, args ...any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, Call{Method: method, Args: args})
+}
+
+func notConfigured(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotConfigured, method)
+}
+
+// notConfiguredSeq returns an iterator yielding the not configured error of the method.
+func notConfiguredSeq[T any](method string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, notConfigured(method))
+	}
+}
+
+// Seq returns an iterator yielding the items, followed by err when it is not nil. It is a convenience for
+// programming the iterator methods of Client.
+func Seq[T any](items []T, err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+
+		if err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package retoolmock_test
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+	"github.com/thoughtgears/retoolsdk/retoolmock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// deactivate is an example consumer depending only on the users interface.
+func deactivate(ctx context.Context, users retool.UsersAPI, email string) error {
+	found, err := users.ListUsers(ctx, &retool.ListUserOpts{Email: email})
+	if err != nil {
+		return err
+	}
+
+	for _, user := range found {
+		if err := users.DeleteUser(ctx, user.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestClient_RecordsCalls(t *testing.T) {
+	mock := &retoolmock.Client{
+		ListUsersFunc: func(ctx context.Context, opts *retool.ListUserOpts) ([]retool.User, error) {
+			return []retool.User{{ID: "user_1", Email: opts.Email}}, nil
+		},
+		DeleteUserFunc: func(ctx context.Context, id string) error {
+			return nil
+		},
+	}
+
+	err := deactivate(context.Background(), mock, "jane@example.com")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []retoolmock.Call{
+		{Method: "ListUsers", Args: []any{&retool.ListUserOpts{Email: "jane@example.com"}}},
+		{Method: "DeleteUser", Args: []any{"user_1"}},
+	}, mock.Calls())
+	assert.Len(t, mock.CallsTo("DeleteUser"), 1)
+
+	mock.Reset()
+	assert.Empty(t, mock.Calls())
+}
+
+func TestClient_ProgrammedError(t *testing.T) {
+	mock := &retoolmock.Client{
+		GetGroupFunc: func(ctx context.Context, id string) (*retool.Group, error) {
+			return nil, &retool.APIError{StatusCode: 404, Message: "Group not found"}
+		},
+	}
+
+	_, err := mock.GetGroup(context.Background(), "42")
+	assert.ErrorIs(t, err, retool.ErrNotFound)
+}
+
+func TestClient_NotConfigured(t *testing.T) {
+	mock := &retoolmock.Client{}
+
+	_, err := mock.GetFolder(context.Background(), "folder_1")
+	assert.ErrorIs(t, err, retoolmock.ErrNotConfigured)
+	assert.EqualError(t, err, "retoolmock: method not configured: GetFolder")
+
+	for _, err := range mock.AllSpaces(context.Background()) {
+		assert.ErrorIs(t, err, retoolmock.ErrNotConfigured)
+	}
+
+	assert.Len(t, mock.Calls(), 2)
+}
+
+func TestSeq(t *testing.T) {
+	failure := errors.New("page failed")
+	mock := &retoolmock.Client{
+		AllGroupsFunc: func(ctx context.Context) iter.Seq2[retool.Group, error] {
+			return retoolmock.Seq([]retool.Group{{ID: 1}, {ID: 2}}, failure)
+		},
+	}
+
+	var ids []int
+	var errs []error
+	for group, err := range mock.AllGroups(context.Background()) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ids = append(ids, group.ID)
+	}
+
+	assert.Equal(t, []int{1, 2}, ids)
+	assert.Equal(t, []error{failure}, errs)
+}
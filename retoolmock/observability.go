@@ -0,0 +1,88 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetObservabilityConfig records the call and returns the result of GetObservabilityConfigFunc.
+func (c *Client) GetObservabilityConfig(ctx context.Context, id string) (*retool.ObservabilityConfig, error) {
+	c.record("GetObservabilityConfig", id)
+	if c.GetObservabilityConfigFunc == nil {
+		return nil, notConfigured("GetObservabilityConfig")
+	}
+
+	return c.GetObservabilityConfigFunc(ctx, id)
+}
+
+// ListObservabilityConfigs records the call and returns the result of ListObservabilityConfigsFunc.
+func (c *Client) ListObservabilityConfigs(ctx context.Context) ([]retool.ObservabilityConfig, error) {
+	c.record("ListObservabilityConfigs")
+	if c.ListObservabilityConfigsFunc == nil {
+		return nil, notConfigured("ListObservabilityConfigs")
+	}
+
+	return c.ListObservabilityConfigsFunc(ctx)
+}
+
+// AllObservabilityConfigs records the call and returns the result of AllObservabilityConfigsFunc.
+func (c *Client) AllObservabilityConfigs(ctx context.Context) iter.Seq2[retool.ObservabilityConfig, error] {
+	c.record("AllObservabilityConfigs")
+	if c.AllObservabilityConfigsFunc == nil {
+		return notConfiguredSeq[retool.ObservabilityConfig]("AllObservabilityConfigs")
+	}
+
+	return c.AllObservabilityConfigsFunc(ctx)
+}
+
+// ObservabilityConfigPages records the call and returns the result of ObservabilityConfigPagesFunc.
+func (c *Client) ObservabilityConfigPages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.ObservabilityConfig], error] {
+	c.record("ObservabilityConfigPages", next)
+	if c.ObservabilityConfigPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.ObservabilityConfig]]("ObservabilityConfigPages")
+	}
+
+	return c.ObservabilityConfigPagesFunc(ctx, next)
+}
+
+// CreateObservabilityConfig records the call and returns the result of CreateObservabilityConfigFunc.
+func (c *Client) CreateObservabilityConfig(ctx context.Context, config *retool.ObservabilityConfig) (*retool.ObservabilityConfig, error) {
+	c.record("CreateObservabilityConfig", config)
+	if c.CreateObservabilityConfigFunc == nil {
+		return nil, notConfigured("CreateObservabilityConfig")
+	}
+
+	return c.CreateObservabilityConfigFunc(ctx, config)
+}
+
+// UpdateObservabilityConfig records the call and returns the result of UpdateObservabilityConfigFunc.
+func (c *Client) UpdateObservabilityConfig(ctx context.Context, id string, config *retool.ObservabilityConfig) (*retool.ObservabilityConfig, error) {
+	c.record("UpdateObservabilityConfig", id, config)
+	if c.UpdateObservabilityConfigFunc == nil {
+		return nil, notConfigured("UpdateObservabilityConfig")
+	}
+
+	return c.UpdateObservabilityConfigFunc(ctx, id, config)
+}
+
+// DeleteObservabilityConfig records the call and returns the result of DeleteObservabilityConfigFunc.
+func (c *Client) DeleteObservabilityConfig(ctx context.Context, id string) error {
+	c.record("DeleteObservabilityConfig", id)
+	if c.DeleteObservabilityConfigFunc == nil {
+		return notConfigured("DeleteObservabilityConfig")
+	}
+
+	return c.DeleteObservabilityConfigFunc(ctx, id)
+}
+
+// TestObservabilityConnection records the call and returns the result of TestObservabilityConnectionFunc.
+func (c *Client) TestObservabilityConnection(ctx context.Context, config *retool.ObservabilityConfig) (*retool.ConnectionTestResult, error) {
+	c.record("TestObservabilityConnection", config)
+	if c.TestObservabilityConnectionFunc == nil {
+		return nil, notConfigured("TestObservabilityConnection")
+	}
+
+	return c.TestObservabilityConnectionFunc(ctx, config)
+}
@@ -0,0 +1,47 @@
+package retoolmock
+
+import (
+	"context"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetFolderOrAppAccessList records the call and returns the result of GetFolderOrAppAccessListFunc.
+func (c *Client) GetFolderOrAppAccessList(ctx context.Context, objectID, objectType retool.ObjectType) (*retool.GroupedData, error) {
+	c.record("GetFolderOrAppAccessList", objectID, objectType)
+	if c.GetFolderOrAppAccessListFunc == nil {
+		return nil, notConfigured("GetFolderOrAppAccessList")
+	}
+
+	return c.GetFolderOrAppAccessListFunc(ctx, objectID, objectType)
+}
+
+// ListGroupObjectPermissions records the call and returns the result of ListGroupObjectPermissionsFunc.
+func (c *Client) ListGroupObjectPermissions(ctx context.Context, subject string, objectType retool.ObjectType, id any) ([]retool.Subject, error) {
+	c.record("ListGroupObjectPermissions", subject, objectType, id)
+	if c.ListGroupObjectPermissionsFunc == nil {
+		return nil, notConfigured("ListGroupObjectPermissions")
+	}
+
+	return c.ListGroupObjectPermissionsFunc(ctx, subject, objectType, id)
+}
+
+// GrantPermission records the call and returns the result of GrantPermissionFunc.
+func (c *Client) GrantPermission(ctx context.Context, subject string, subjectID any, objectType retool.ObjectType, objectID string, accessLevel retool.AccessLevel) ([]retool.Subject, error) {
+	c.record("GrantPermission", subject, subjectID, objectType, objectID, accessLevel)
+	if c.GrantPermissionFunc == nil {
+		return nil, notConfigured("GrantPermission")
+	}
+
+	return c.GrantPermissionFunc(ctx, subject, subjectID, objectType, objectID, accessLevel)
+}
+
+// RevokePermission records the call and returns the result of RevokePermissionFunc.
+func (c *Client) RevokePermission(ctx context.Context, subject string, subjectID any, objectType retool.ObjectType, objectID string) ([]retool.Subject, error) {
+	c.record("RevokePermission", subject, subjectID, objectType, objectID)
+	if c.RevokePermissionFunc == nil {
+		return nil, notConfigured("RevokePermission")
+	}
+
+	return c.RevokePermissionFunc(ctx, subject, subjectID, objectType, objectID)
+}
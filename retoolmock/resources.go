@@ -0,0 +1,148 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetResource records the call and returns the result of GetResourceFunc.
+func (c *Client) GetResource(ctx context.Context, id string) (*retool.Resource, error) {
+	c.record("GetResource", id)
+	if c.GetResourceFunc == nil {
+		return nil, notConfigured("GetResource")
+	}
+
+	return c.GetResourceFunc(ctx, id)
+}
+
+// ListResources records the call and returns the result of ListResourcesFunc.
+func (c *Client) ListResources(ctx context.Context, opts *retool.ListResourcesOpts) ([]retool.Resource, error) {
+	c.record("ListResources", opts)
+	if c.ListResourcesFunc == nil {
+		return nil, notConfigured("ListResources")
+	}
+
+	return c.ListResourcesFunc(ctx, opts)
+}
+
+// AllResources records the call and returns the result of AllResourcesFunc.
+func (c *Client) AllResources(ctx context.Context, opts *retool.ListResourcesOpts) iter.Seq2[retool.Resource, error] {
+	c.record("AllResources", opts)
+	if c.AllResourcesFunc == nil {
+		return notConfiguredSeq[retool.Resource]("AllResources")
+	}
+
+	return c.AllResourcesFunc(ctx, opts)
+}
+
+// ResourcePages records the call and returns the result of ResourcePagesFunc.
+func (c *Client) ResourcePages(ctx context.Context, opts *retool.ListResourcesOpts, next string) iter.Seq2[*retool.Page[retool.Resource], error] {
+	c.record("ResourcePages", opts, next)
+	if c.ResourcePagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.Resource]]("ResourcePages")
+	}
+
+	return c.ResourcePagesFunc(ctx, opts, next)
+}
+
+// CreateResource records the call and returns the result of CreateResourceFunc.
+func (c *Client) CreateResource(ctx context.Context, displayName, folderID string, options retool.ResourceOptions) (*retool.Resource, error) {
+	c.record("CreateResource", displayName, folderID, options)
+	if c.CreateResourceFunc == nil {
+		return nil, notConfigured("CreateResource")
+	}
+
+	return c.CreateResourceFunc(ctx, displayName, folderID, options)
+}
+
+// UpdateResource records the call and returns the result of UpdateResourceFunc.
+func (c *Client) UpdateResource(ctx context.Context, id, displayName, folderID string, options retool.ResourceOptions) (*retool.Resource, error) {
+	c.record("UpdateResource", id, displayName, folderID, options)
+	if c.UpdateResourceFunc == nil {
+		return nil, notConfigured("UpdateResource")
+	}
+
+	return c.UpdateResourceFunc(ctx, id, displayName, folderID, options)
+}
+
+// DeleteResource records the call and returns the result of DeleteResourceFunc.
+func (c *Client) DeleteResource(ctx context.Context, id string) error {
+	c.record("DeleteResource", id)
+	if c.DeleteResourceFunc == nil {
+		return notConfigured("DeleteResource")
+	}
+
+	return c.DeleteResourceFunc(ctx, id)
+}
+
+// ListResourceConfigurations records the call and returns the result of ListResourceConfigurationsFunc.
+func (c *Client) ListResourceConfigurations(ctx context.Context, resourceID string) ([]retool.ResourceConfiguration, error) {
+	c.record("ListResourceConfigurations", resourceID)
+	if c.ListResourceConfigurationsFunc == nil {
+		return nil, notConfigured("ListResourceConfigurations")
+	}
+
+	return c.ListResourceConfigurationsFunc(ctx, resourceID)
+}
+
+// AllResourceConfigurations records the call and returns the result of AllResourceConfigurationsFunc.
+func (c *Client) AllResourceConfigurations(ctx context.Context, resourceID string) iter.Seq2[retool.ResourceConfiguration, error] {
+	c.record("AllResourceConfigurations", resourceID)
+	if c.AllResourceConfigurationsFunc == nil {
+		return notConfiguredSeq[retool.ResourceConfiguration]("AllResourceConfigurations")
+	}
+
+	return c.AllResourceConfigurationsFunc(ctx, resourceID)
+}
+
+// ResourceConfigurationPages records the call and returns the result of ResourceConfigurationPagesFunc.
+func (c *Client) ResourceConfigurationPages(ctx context.Context, resourceID, next string) iter.Seq2[*retool.Page[retool.ResourceConfiguration], error] {
+	c.record("ResourceConfigurationPages", resourceID, next)
+	if c.ResourceConfigurationPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.ResourceConfiguration]]("ResourceConfigurationPages")
+	}
+
+	return c.ResourceConfigurationPagesFunc(ctx, resourceID, next)
+}
+
+// GetResourceConfiguration records the call and returns the result of GetResourceConfigurationFunc.
+func (c *Client) GetResourceConfiguration(ctx context.Context, resourceID, configurationID string) (*retool.ResourceConfiguration, error) {
+	c.record("GetResourceConfiguration", resourceID, configurationID)
+	if c.GetResourceConfigurationFunc == nil {
+		return nil, notConfigured("GetResourceConfiguration")
+	}
+
+	return c.GetResourceConfigurationFunc(ctx, resourceID, configurationID)
+}
+
+// CreateResourceConfiguration records the call and returns the result of CreateResourceConfigurationFunc.
+func (c *Client) CreateResourceConfiguration(ctx context.Context, resourceID, environmentID string, options retool.ResourceOptions) (*retool.ResourceConfiguration, error) {
+	c.record("CreateResourceConfiguration", resourceID, environmentID, options)
+	if c.CreateResourceConfigurationFunc == nil {
+		return nil, notConfigured("CreateResourceConfiguration")
+	}
+
+	return c.CreateResourceConfigurationFunc(ctx, resourceID, environmentID, options)
+}
+
+// UpdateResourceConfiguration records the call and returns the result of UpdateResourceConfigurationFunc.
+func (c *Client) UpdateResourceConfiguration(ctx context.Context, resourceID, configurationID, environmentID string, options retool.ResourceOptions) (*retool.ResourceConfiguration, error) {
+	c.record("UpdateResourceConfiguration", resourceID, configurationID, environmentID, options)
+	if c.UpdateResourceConfigurationFunc == nil {
+		return nil, notConfigured("UpdateResourceConfiguration")
+	}
+
+	return c.UpdateResourceConfigurationFunc(ctx, resourceID, configurationID, environmentID, options)
+}
+
+// DeleteResourceConfiguration records the call and returns the result of DeleteResourceConfigurationFunc.
+func (c *Client) DeleteResourceConfiguration(ctx context.Context, resourceID, configurationID string) error {
+	c.record("DeleteResourceConfiguration", resourceID, configurationID)
+	if c.DeleteResourceConfigurationFunc == nil {
+		return notConfigured("DeleteResourceConfiguration")
+	}
+
+	return c.DeleteResourceConfigurationFunc(ctx, resourceID, configurationID)
+}
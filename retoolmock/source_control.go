@@ -0,0 +1,188 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetSourceControlConfig records the call and returns the result of GetSourceControlConfigFunc.
+func (c *Client) GetSourceControlConfig(ctx context.Context) (*retool.SourceControlConfig, error) {
+	c.record("GetSourceControlConfig")
+	if c.GetSourceControlConfigFunc == nil {
+		return nil, notConfigured("GetSourceControlConfig")
+	}
+
+	return c.GetSourceControlConfigFunc(ctx)
+}
+
+// UpdateSourceControlConfig records the call and returns the result of UpdateSourceControlConfigFunc.
+func (c *Client) UpdateSourceControlConfig(ctx context.Context, config *retool.SourceControlConfig) (*retool.SourceControlConfig, error) {
+	c.record("UpdateSourceControlConfig", config)
+	if c.UpdateSourceControlConfigFunc == nil {
+		return nil, notConfigured("UpdateSourceControlConfig")
+	}
+
+	return c.UpdateSourceControlConfigFunc(ctx, config)
+}
+
+// GetSourceControlSettings records the call and returns the result of GetSourceControlSettingsFunc.
+func (c *Client) GetSourceControlSettings(ctx context.Context) (*retool.SourceControlSettings, error) {
+	c.record("GetSourceControlSettings")
+	if c.GetSourceControlSettingsFunc == nil {
+		return nil, notConfigured("GetSourceControlSettings")
+	}
+
+	return c.GetSourceControlSettingsFunc(ctx)
+}
+
+// UpdateSourceControlSettings records the call and returns the result of UpdateSourceControlSettingsFunc.
+func (c *Client) UpdateSourceControlSettings(ctx context.Context, settings retool.SourceControlSettings) (*retool.SourceControlSettings, error) {
+	c.record("UpdateSourceControlSettings", settings)
+	if c.UpdateSourceControlSettingsFunc == nil {
+		return nil, notConfigured("UpdateSourceControlSettings")
+	}
+
+	return c.UpdateSourceControlSettingsFunc(ctx, settings)
+}
+
+// TestSourceControlConnection records the call and returns the result of TestSourceControlConnectionFunc.
+func (c *Client) TestSourceControlConnection(ctx context.Context, config *retool.SourceControlConfig) (*retool.ConnectionTestResult, error) {
+	c.record("TestSourceControlConnection", config)
+	if c.TestSourceControlConnectionFunc == nil {
+		return nil, notConfigured("TestSourceControlConnection")
+	}
+
+	return c.TestSourceControlConnectionFunc(ctx, config)
+}
+
+// ListBranches records the call and returns the result of ListBranchesFunc.
+func (c *Client) ListBranches(ctx context.Context) ([]retool.Branch, error) {
+	c.record("ListBranches")
+	if c.ListBranchesFunc == nil {
+		return nil, notConfigured("ListBranches")
+	}
+
+	return c.ListBranchesFunc(ctx)
+}
+
+// AllBranches records the call and returns the result of AllBranchesFunc.
+func (c *Client) AllBranches(ctx context.Context) iter.Seq2[retool.Branch, error] {
+	c.record("AllBranches")
+	if c.AllBranchesFunc == nil {
+		return notConfiguredSeq[retool.Branch]("AllBranches")
+	}
+
+	return c.AllBranchesFunc(ctx)
+}
+
+// BranchPages records the call and returns the result of BranchPagesFunc.
+func (c *Client) BranchPages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Branch], error] {
+	c.record("BranchPages", next)
+	if c.BranchPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.Branch]]("BranchPages")
+	}
+
+	return c.BranchPagesFunc(ctx, next)
+}
+
+// CreateBranch records the call and returns the result of CreateBranchFunc.
+func (c *Client) CreateBranch(ctx context.Context, name, source string) (*retool.Branch, error) {
+	c.record("CreateBranch", name, source)
+	if c.CreateBranchFunc == nil {
+		return nil, notConfigured("CreateBranch")
+	}
+
+	return c.CreateBranchFunc(ctx, name, source)
+}
+
+// DeleteBranch records the call and returns the result of DeleteBranchFunc.
+func (c *Client) DeleteBranch(ctx context.Context, name string) error {
+	c.record("DeleteBranch", name)
+	if c.DeleteBranchFunc == nil {
+		return notConfigured("DeleteBranch")
+	}
+
+	return c.DeleteBranchFunc(ctx, name)
+}
+
+// ListCommits records the call and returns the result of ListCommitsFunc.
+func (c *Client) ListCommits(ctx context.Context, opts *retool.ListCommitsOpts) ([]retool.Commit, error) {
+	c.record("ListCommits", opts)
+	if c.ListCommitsFunc == nil {
+		return nil, notConfigured("ListCommits")
+	}
+
+	return c.ListCommitsFunc(ctx, opts)
+}
+
+// AllCommits records the call and returns the result of AllCommitsFunc.
+func (c *Client) AllCommits(ctx context.Context, opts *retool.ListCommitsOpts) iter.Seq2[retool.Commit, error] {
+	c.record("AllCommits", opts)
+	if c.AllCommitsFunc == nil {
+		return notConfiguredSeq[retool.Commit]("AllCommits")
+	}
+
+	return c.AllCommitsFunc(ctx, opts)
+}
+
+// CommitPages records the call and returns the result of CommitPagesFunc.
+func (c *Client) CommitPages(ctx context.Context, opts *retool.ListCommitsOpts, next string) iter.Seq2[*retool.Page[retool.Commit], error] {
+	c.record("CommitPages", opts, next)
+	if c.CommitPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.Commit]]("CommitPages")
+	}
+
+	return c.CommitPagesFunc(ctx, opts, next)
+}
+
+// TriggerDeploy records the call and returns the result of TriggerDeployFunc.
+func (c *Client) TriggerDeploy(ctx context.Context, opts *retool.TriggerDeployOpts) (*retool.Deploy, error) {
+	c.record("TriggerDeploy", opts)
+	if c.TriggerDeployFunc == nil {
+		return nil, notConfigured("TriggerDeploy")
+	}
+
+	return c.TriggerDeployFunc(ctx, opts)
+}
+
+// ListDeploys records the call and returns the result of ListDeploysFunc.
+func (c *Client) ListDeploys(ctx context.Context) ([]retool.Deploy, error) {
+	c.record("ListDeploys")
+	if c.ListDeploysFunc == nil {
+		return nil, notConfigured("ListDeploys")
+	}
+
+	return c.ListDeploysFunc(ctx)
+}
+
+// AllDeploys records the call and returns the result of AllDeploysFunc.
+func (c *Client) AllDeploys(ctx context.Context) iter.Seq2[retool.Deploy, error] {
+	c.record("AllDeploys")
+	if c.AllDeploysFunc == nil {
+		return notConfiguredSeq[retool.Deploy]("AllDeploys")
+	}
+
+	return c.AllDeploysFunc(ctx)
+}
+
+// DeployPages records the call and returns the result of DeployPagesFunc.
+func (c *Client) DeployPages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Deploy], error] {
+	c.record("DeployPages", next)
+	if c.DeployPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.Deploy]]("DeployPages")
+	}
+
+	return c.DeployPagesFunc(ctx, next)
+}
+
+// GetDeploy records the call and returns the result of GetDeployFunc.
+func (c *Client) GetDeploy(ctx context.Context, id string) (*retool.Deploy, error) {
+	c.record("GetDeploy", id)
+	if c.GetDeployFunc == nil {
+		return nil, notConfigured("GetDeploy")
+	}
+
+	return c.GetDeployFunc(ctx, id)
+}
@@ -0,0 +1,78 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetSpace records the call and returns the result of GetSpaceFunc.
+func (c *Client) GetSpace(ctx context.Context, id string) (*retool.Space, error) {
+	c.record("GetSpace", id)
+	if c.GetSpaceFunc == nil {
+		return nil, notConfigured("GetSpace")
+	}
+
+	return c.GetSpaceFunc(ctx, id)
+}
+
+// ListSpaces records the call and returns the result of ListSpacesFunc.
+func (c *Client) ListSpaces(ctx context.Context) ([]retool.Space, error) {
+	c.record("ListSpaces")
+	if c.ListSpacesFunc == nil {
+		return nil, notConfigured("ListSpaces")
+	}
+
+	return c.ListSpacesFunc(ctx)
+}
+
+// AllSpaces records the call and returns the result of AllSpacesFunc.
+func (c *Client) AllSpaces(ctx context.Context) iter.Seq2[retool.Space, error] {
+	c.record("AllSpaces")
+	if c.AllSpacesFunc == nil {
+		return notConfiguredSeq[retool.Space]("AllSpaces")
+	}
+
+	return c.AllSpacesFunc(ctx)
+}
+
+// SpacePages records the call and returns the result of SpacePagesFunc.
+func (c *Client) SpacePages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Space], error] {
+	c.record("SpacePages", next)
+	if c.SpacePagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.Space]]("SpacePages")
+	}
+
+	return c.SpacePagesFunc(ctx, next)
+}
+
+// CreateSpace records the call and returns the result of CreateSpaceFunc.
+func (c *Client) CreateSpace(ctx context.Context, name, domain string, options *retool.CreateSpaceOptions) (*retool.Space, error) {
+	c.record("CreateSpace", name, domain, options)
+	if c.CreateSpaceFunc == nil {
+		return nil, notConfigured("CreateSpace")
+	}
+
+	return c.CreateSpaceFunc(ctx, name, domain, options)
+}
+
+// UpdateSpace records the call and returns the result of UpdateSpaceFunc.
+func (c *Client) UpdateSpace(ctx context.Context, id, name, domain string) (*retool.Space, error) {
+	c.record("UpdateSpace", id, name, domain)
+	if c.UpdateSpaceFunc == nil {
+		return nil, notConfigured("UpdateSpace")
+	}
+
+	return c.UpdateSpaceFunc(ctx, id, name, domain)
+}
+
+// DeleteSpace records the call and returns the result of DeleteSpaceFunc.
+func (c *Client) DeleteSpace(ctx context.Context, id string) error {
+	c.record("DeleteSpace", id)
+	if c.DeleteSpaceFunc == nil {
+		return notConfigured("DeleteSpace")
+	}
+
+	return c.DeleteSpaceFunc(ctx, id)
+}
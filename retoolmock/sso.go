@@ -0,0 +1,37 @@
+package retoolmock
+
+import (
+	"context"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetSSOConfig records the call and returns the result of GetSSOConfigFunc.
+func (c *Client) GetSSOConfig(ctx context.Context) (retool.SSOConfig, error) {
+	c.record("GetSSOConfig")
+	if c.GetSSOConfigFunc == nil {
+		return nil, notConfigured("GetSSOConfig")
+	}
+
+	return c.GetSSOConfigFunc(ctx)
+}
+
+// SetSSOConfig records the call and returns the result of SetSSOConfigFunc.
+func (c *Client) SetSSOConfig(ctx context.Context, config retool.SSOConfig) (retool.SSOConfig, error) {
+	c.record("SetSSOConfig", config)
+	if c.SetSSOConfigFunc == nil {
+		return nil, notConfigured("SetSSOConfig")
+	}
+
+	return c.SetSSOConfigFunc(ctx, config)
+}
+
+// DeleteSSOConfig records the call and returns the result of DeleteSSOConfigFunc.
+func (c *Client) DeleteSSOConfig(ctx context.Context) error {
+	c.record("DeleteSSOConfig")
+	if c.DeleteSSOConfigFunc == nil {
+		return notConfigured("DeleteSSOConfig")
+	}
+
+	return c.DeleteSSOConfigFunc(ctx)
+}
@@ -0,0 +1,98 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetTheme records the call and returns the result of GetThemeFunc.
+func (c *Client) GetTheme(ctx context.Context, id string) (*retool.Theme, error) {
+	c.record("GetTheme", id)
+	if c.GetThemeFunc == nil {
+		return nil, notConfigured("GetTheme")
+	}
+
+	return c.GetThemeFunc(ctx, id)
+}
+
+// ListThemes records the call and returns the result of ListThemesFunc.
+func (c *Client) ListThemes(ctx context.Context) ([]retool.Theme, error) {
+	c.record("ListThemes")
+	if c.ListThemesFunc == nil {
+		return nil, notConfigured("ListThemes")
+	}
+
+	return c.ListThemesFunc(ctx)
+}
+
+// AllThemes records the call and returns the result of AllThemesFunc.
+func (c *Client) AllThemes(ctx context.Context) iter.Seq2[retool.Theme, error] {
+	c.record("AllThemes")
+	if c.AllThemesFunc == nil {
+		return notConfiguredSeq[retool.Theme]("AllThemes")
+	}
+
+	return c.AllThemesFunc(ctx)
+}
+
+// ThemePages records the call and returns the result of ThemePagesFunc.
+func (c *Client) ThemePages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Theme], error] {
+	c.record("ThemePages", next)
+	if c.ThemePagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.Theme]]("ThemePages")
+	}
+
+	return c.ThemePagesFunc(ctx, next)
+}
+
+// CreateTheme records the call and returns the result of CreateThemeFunc.
+func (c *Client) CreateTheme(ctx context.Context, theme *retool.Theme) (*retool.Theme, error) {
+	c.record("CreateTheme", theme)
+	if c.CreateThemeFunc == nil {
+		return nil, notConfigured("CreateTheme")
+	}
+
+	return c.CreateThemeFunc(ctx, theme)
+}
+
+// UpdateTheme records the call and returns the result of UpdateThemeFunc.
+func (c *Client) UpdateTheme(ctx context.Context, id string, theme *retool.Theme) (*retool.Theme, error) {
+	c.record("UpdateTheme", id, theme)
+	if c.UpdateThemeFunc == nil {
+		return nil, notConfigured("UpdateTheme")
+	}
+
+	return c.UpdateThemeFunc(ctx, id, theme)
+}
+
+// DeleteTheme records the call and returns the result of DeleteThemeFunc.
+func (c *Client) DeleteTheme(ctx context.Context, id string) error {
+	c.record("DeleteTheme", id)
+	if c.DeleteThemeFunc == nil {
+		return notConfigured("DeleteTheme")
+	}
+
+	return c.DeleteThemeFunc(ctx, id)
+}
+
+// SetDefaultTheme records the call and returns the result of SetDefaultThemeFunc.
+func (c *Client) SetDefaultTheme(ctx context.Context, id string) (*retool.Theme, error) {
+	c.record("SetDefaultTheme", id)
+	if c.SetDefaultThemeFunc == nil {
+		return nil, notConfigured("SetDefaultTheme")
+	}
+
+	return c.SetDefaultThemeFunc(ctx, id)
+}
+
+// CopyThemeToSpace records the call and returns the result of CopyThemeToSpaceFunc.
+func (c *Client) CopyThemeToSpace(ctx context.Context, id string, child retool.ThemesAPI, opts *retool.CopyThemeOpts) (*retool.Theme, error) {
+	c.record("CopyThemeToSpace", id, child, opts)
+	if c.CopyThemeToSpaceFunc == nil {
+		return nil, notConfigured("CopyThemeToSpace")
+	}
+
+	return c.CopyThemeToSpaceFunc(ctx, id, child, opts)
+}
@@ -0,0 +1,89 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetUsage records the call and returns the result of GetUsageFunc.
+func (c *Client) GetUsage(ctx context.Context, start, end time.Time, opts *retool.UsageOpts) (*retool.Usage, error) {
+	c.record("GetUsage", start, end, opts)
+	if c.GetUsageFunc == nil {
+		return nil, notConfigured("GetUsage")
+	}
+
+	return c.GetUsageFunc(ctx, start, end, opts)
+}
+
+// ListAppUsage records the call and returns the result of ListAppUsageFunc.
+func (c *Client) ListAppUsage(ctx context.Context, start, end time.Time, opts *retool.UsageOpts) ([]retool.AppUsage, error) {
+	c.record("ListAppUsage", start, end, opts)
+	if c.ListAppUsageFunc == nil {
+		return nil, notConfigured("ListAppUsage")
+	}
+
+	return c.ListAppUsageFunc(ctx, start, end, opts)
+}
+
+// AllAppUsage records the call and returns the result of AllAppUsageFunc.
+func (c *Client) AllAppUsage(ctx context.Context, start, end time.Time, opts *retool.UsageOpts) iter.Seq2[retool.AppUsage, error] {
+	c.record("AllAppUsage", start, end, opts)
+	if c.AllAppUsageFunc == nil {
+		return notConfiguredSeq[retool.AppUsage]("AllAppUsage")
+	}
+
+	return c.AllAppUsageFunc(ctx, start, end, opts)
+}
+
+// AppUsagePages records the call and returns the result of AppUsagePagesFunc.
+func (c *Client) AppUsagePages(ctx context.Context, start, end time.Time, opts *retool.UsageOpts, next string) iter.Seq2[*retool.Page[retool.AppUsage], error] {
+	c.record("AppUsagePages", start, end, opts, next)
+	if c.AppUsagePagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.AppUsage]]("AppUsagePages")
+	}
+
+	return c.AppUsagePagesFunc(ctx, start, end, opts, next)
+}
+
+// ListUserUsage records the call and returns the result of ListUserUsageFunc.
+func (c *Client) ListUserUsage(ctx context.Context, start, end time.Time, opts *retool.UsageOpts) ([]retool.UserUsage, error) {
+	c.record("ListUserUsage", start, end, opts)
+	if c.ListUserUsageFunc == nil {
+		return nil, notConfigured("ListUserUsage")
+	}
+
+	return c.ListUserUsageFunc(ctx, start, end, opts)
+}
+
+// AllUserUsage records the call and returns the result of AllUserUsageFunc.
+func (c *Client) AllUserUsage(ctx context.Context, start, end time.Time, opts *retool.UsageOpts) iter.Seq2[retool.UserUsage, error] {
+	c.record("AllUserUsage", start, end, opts)
+	if c.AllUserUsageFunc == nil {
+		return notConfiguredSeq[retool.UserUsage]("AllUserUsage")
+	}
+
+	return c.AllUserUsageFunc(ctx, start, end, opts)
+}
+
+// UserUsagePages records the call and returns the result of UserUsagePagesFunc.
+func (c *Client) UserUsagePages(ctx context.Context, start, end time.Time, opts *retool.UsageOpts, next string) iter.Seq2[*retool.Page[retool.UserUsage], error] {
+	c.record("UserUsagePages", start, end, opts, next)
+	if c.UserUsagePagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.UserUsage]]("UserUsagePages")
+	}
+
+	return c.UserUsagePagesFunc(ctx, start, end, opts, next)
+}
+
+// GetSeatUtilization records the call and returns the result of GetSeatUtilizationFunc.
+func (c *Client) GetSeatUtilization(ctx context.Context, start, end time.Time) ([]retool.SeatUtilization, error) {
+	c.record("GetSeatUtilization", start, end)
+	if c.GetSeatUtilizationFunc == nil {
+		return nil, notConfigured("GetSeatUtilization")
+	}
+
+	return c.GetSeatUtilizationFunc(ctx, start, end)
+}
@@ -0,0 +1,78 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// CreateUserInvite records the call and returns the result of CreateUserInviteFunc.
+func (c *Client) CreateUserInvite(ctx context.Context, email string, opts *retool.CreateUserInviteOpts) (*retool.UserInvite, error) {
+	c.record("CreateUserInvite", email, opts)
+	if c.CreateUserInviteFunc == nil {
+		return nil, notConfigured("CreateUserInvite")
+	}
+
+	return c.CreateUserInviteFunc(ctx, email, opts)
+}
+
+// GetUserInvite records the call and returns the result of GetUserInviteFunc.
+func (c *Client) GetUserInvite(ctx context.Context, id string) (*retool.UserInvite, error) {
+	c.record("GetUserInvite", id)
+	if c.GetUserInviteFunc == nil {
+		return nil, notConfigured("GetUserInvite")
+	}
+
+	return c.GetUserInviteFunc(ctx, id)
+}
+
+// ListUserInvites records the call and returns the result of ListUserInvitesFunc.
+func (c *Client) ListUserInvites(ctx context.Context) ([]retool.UserInvite, error) {
+	c.record("ListUserInvites")
+	if c.ListUserInvitesFunc == nil {
+		return nil, notConfigured("ListUserInvites")
+	}
+
+	return c.ListUserInvitesFunc(ctx)
+}
+
+// AllUserInvites records the call and returns the result of AllUserInvitesFunc.
+func (c *Client) AllUserInvites(ctx context.Context) iter.Seq2[retool.UserInvite, error] {
+	c.record("AllUserInvites")
+	if c.AllUserInvitesFunc == nil {
+		return notConfiguredSeq[retool.UserInvite]("AllUserInvites")
+	}
+
+	return c.AllUserInvitesFunc(ctx)
+}
+
+// UserInvitePages records the call and returns the result of UserInvitePagesFunc.
+func (c *Client) UserInvitePages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.UserInvite], error] {
+	c.record("UserInvitePages", next)
+	if c.UserInvitePagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.UserInvite]]("UserInvitePages")
+	}
+
+	return c.UserInvitePagesFunc(ctx, next)
+}
+
+// RevokeUserInvite records the call and returns the result of RevokeUserInviteFunc.
+func (c *Client) RevokeUserInvite(ctx context.Context, id string) error {
+	c.record("RevokeUserInvite", id)
+	if c.RevokeUserInviteFunc == nil {
+		return notConfigured("RevokeUserInvite")
+	}
+
+	return c.RevokeUserInviteFunc(ctx, id)
+}
+
+// ResendUserInvite records the call and returns the result of ResendUserInviteFunc.
+func (c *Client) ResendUserInvite(ctx context.Context, id string) (*retool.UserInvite, error) {
+	c.record("ResendUserInvite", id)
+	if c.ResendUserInviteFunc == nil {
+		return nil, notConfigured("ResendUserInvite")
+	}
+
+	return c.ResendUserInviteFunc(ctx, id)
+}
@@ -0,0 +1,128 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetUser records the call and returns the result of GetUserFunc.
+func (c *Client) GetUser(ctx context.Context, id string) (*retool.User, error) {
+	c.record("GetUser", id)
+	if c.GetUserFunc == nil {
+		return nil, notConfigured("GetUser")
+	}
+
+	return c.GetUserFunc(ctx, id)
+}
+
+// ListUsers records the call and returns the result of ListUsersFunc.
+func (c *Client) ListUsers(ctx context.Context, opts *retool.ListUserOpts) ([]retool.User, error) {
+	c.record("ListUsers", opts)
+	if c.ListUsersFunc == nil {
+		return nil, notConfigured("ListUsers")
+	}
+
+	return c.ListUsersFunc(ctx, opts)
+}
+
+// AllUsers records the call and returns the result of AllUsersFunc.
+func (c *Client) AllUsers(ctx context.Context, opts *retool.ListUserOpts) iter.Seq2[retool.User, error] {
+	c.record("AllUsers", opts)
+	if c.AllUsersFunc == nil {
+		return notConfiguredSeq[retool.User]("AllUsers")
+	}
+
+	return c.AllUsersFunc(ctx, opts)
+}
+
+// UserPages records the call and returns the result of UserPagesFunc.
+func (c *Client) UserPages(ctx context.Context, opts *retool.ListUserOpts, next string) iter.Seq2[*retool.Page[retool.User], error] {
+	c.record("UserPages", opts, next)
+	if c.UserPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.User]]("UserPages")
+	}
+
+	return c.UserPagesFunc(ctx, opts, next)
+}
+
+// CreateUser records the call and returns the result of CreateUserFunc.
+func (c *Client) CreateUser(ctx context.Context, email, firstName, lastName string, opts *retool.CreateUserOpts) (*retool.User, error) {
+	c.record("CreateUser", email, firstName, lastName, opts)
+	if c.CreateUserFunc == nil {
+		return nil, notConfigured("CreateUser")
+	}
+
+	return c.CreateUserFunc(ctx, email, firstName, lastName, opts)
+}
+
+// UpdateUser records the call and returns the result of UpdateUserFunc.
+func (c *Client) UpdateUser(ctx context.Context, id string, operations []retool.UpdateOperations) (*retool.User, error) {
+	c.record("UpdateUser", id, operations)
+	if c.UpdateUserFunc == nil {
+		return nil, notConfigured("UpdateUser")
+	}
+
+	return c.UpdateUserFunc(ctx, id, operations)
+}
+
+// DeleteUser records the call and returns the result of DeleteUserFunc.
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	c.record("DeleteUser", id)
+	if c.DeleteUserFunc == nil {
+		return notConfigured("DeleteUser")
+	}
+
+	return c.DeleteUserFunc(ctx, id)
+}
+
+// UpdateUserAttributes records the call and returns the result of UpdateUserAttributesFunc.
+func (c *Client) UpdateUserAttributes(ctx context.Context, id string, attributes []retool.UserAttribute) (map[string]interface{}, error) {
+	c.record("UpdateUserAttributes", id, attributes)
+	if c.UpdateUserAttributesFunc == nil {
+		return nil, notConfigured("UpdateUserAttributes")
+	}
+
+	return c.UpdateUserAttributesFunc(ctx, id, attributes)
+}
+
+// DeleteUserAttribute records the call and returns the result of DeleteUserAttributeFunc.
+func (c *Client) DeleteUserAttribute(ctx context.Context, id, attribute string) (interface{}, error) {
+	c.record("DeleteUserAttribute", id, attribute)
+	if c.DeleteUserAttributeFunc == nil {
+		return nil, notConfigured("DeleteUserAttribute")
+	}
+
+	return c.DeleteUserAttributeFunc(ctx, id, attribute)
+}
+
+// GetOrganizationAttributes records the call and returns the result of GetOrganizationAttributesFunc.
+func (c *Client) GetOrganizationAttributes(ctx context.Context) ([]retool.OrganizationAttribute, error) {
+	c.record("GetOrganizationAttributes")
+	if c.GetOrganizationAttributesFunc == nil {
+		return nil, notConfigured("GetOrganizationAttributes")
+	}
+
+	return c.GetOrganizationAttributesFunc(ctx)
+}
+
+// AllOrganizationAttributes records the call and returns the result of AllOrganizationAttributesFunc.
+func (c *Client) AllOrganizationAttributes(ctx context.Context) iter.Seq2[retool.OrganizationAttribute, error] {
+	c.record("AllOrganizationAttributes")
+	if c.AllOrganizationAttributesFunc == nil {
+		return notConfiguredSeq[retool.OrganizationAttribute]("AllOrganizationAttributes")
+	}
+
+	return c.AllOrganizationAttributesFunc(ctx)
+}
+
+// OrganizationAttributePages records the call and returns the result of OrganizationAttributePagesFunc.
+func (c *Client) OrganizationAttributePages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.OrganizationAttribute], error] {
+	c.record("OrganizationAttributePages", next)
+	if c.OrganizationAttributePagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.OrganizationAttribute]]("OrganizationAttributePages")
+	}
+
+	return c.OrganizationAttributePagesFunc(ctx, next)
+}
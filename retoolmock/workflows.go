@@ -0,0 +1,108 @@
+package retoolmock
+
+import (
+	"context"
+	"iter"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// GetWorkflow records the call and returns the result of GetWorkflowFunc.
+func (c *Client) GetWorkflow(ctx context.Context, id string) (*retool.Workflow, error) {
+	c.record("GetWorkflow", id)
+	if c.GetWorkflowFunc == nil {
+		return nil, notConfigured("GetWorkflow")
+	}
+
+	return c.GetWorkflowFunc(ctx, id)
+}
+
+// ListWorkflows records the call and returns the result of ListWorkflowsFunc.
+func (c *Client) ListWorkflows(ctx context.Context) ([]retool.Workflow, error) {
+	c.record("ListWorkflows")
+	if c.ListWorkflowsFunc == nil {
+		return nil, notConfigured("ListWorkflows")
+	}
+
+	return c.ListWorkflowsFunc(ctx)
+}
+
+// AllWorkflows records the call and returns the result of AllWorkflowsFunc.
+func (c *Client) AllWorkflows(ctx context.Context) iter.Seq2[retool.Workflow, error] {
+	c.record("AllWorkflows")
+	if c.AllWorkflowsFunc == nil {
+		return notConfiguredSeq[retool.Workflow]("AllWorkflows")
+	}
+
+	return c.AllWorkflowsFunc(ctx)
+}
+
+// WorkflowPages records the call and returns the result of WorkflowPagesFunc.
+func (c *Client) WorkflowPages(ctx context.Context, next string) iter.Seq2[*retool.Page[retool.Workflow], error] {
+	c.record("WorkflowPages", next)
+	if c.WorkflowPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.Workflow]]("WorkflowPages")
+	}
+
+	return c.WorkflowPagesFunc(ctx, next)
+}
+
+// TriggerWorkflow records the call and returns the result of TriggerWorkflowFunc.
+func (c *Client) TriggerWorkflow(ctx context.Context, id string, payload any, opts *retool.TriggerWorkflowOpts) (*retool.WorkflowRun, error) {
+	c.record("TriggerWorkflow", id, payload, opts)
+	if c.TriggerWorkflowFunc == nil {
+		return nil, notConfigured("TriggerWorkflow")
+	}
+
+	return c.TriggerWorkflowFunc(ctx, id, payload, opts)
+}
+
+// ListWorkflowRuns records the call and returns the result of ListWorkflowRunsFunc.
+func (c *Client) ListWorkflowRuns(ctx context.Context, workflowID string, opts *retool.ListWorkflowRunsOpts) ([]retool.WorkflowRun, error) {
+	c.record("ListWorkflowRuns", workflowID, opts)
+	if c.ListWorkflowRunsFunc == nil {
+		return nil, notConfigured("ListWorkflowRuns")
+	}
+
+	return c.ListWorkflowRunsFunc(ctx, workflowID, opts)
+}
+
+// AllWorkflowRuns records the call and returns the result of AllWorkflowRunsFunc.
+func (c *Client) AllWorkflowRuns(ctx context.Context, workflowID string, opts *retool.ListWorkflowRunsOpts) iter.Seq2[retool.WorkflowRun, error] {
+	c.record("AllWorkflowRuns", workflowID, opts)
+	if c.AllWorkflowRunsFunc == nil {
+		return notConfiguredSeq[retool.WorkflowRun]("AllWorkflowRuns")
+	}
+
+	return c.AllWorkflowRunsFunc(ctx, workflowID, opts)
+}
+
+// WorkflowRunPages records the call and returns the result of WorkflowRunPagesFunc.
+func (c *Client) WorkflowRunPages(ctx context.Context, workflowID string, opts *retool.ListWorkflowRunsOpts, next string) iter.Seq2[*retool.Page[retool.WorkflowRun], error] {
+	c.record("WorkflowRunPages", workflowID, opts, next)
+	if c.WorkflowRunPagesFunc == nil {
+		return notConfiguredSeq[*retool.Page[retool.WorkflowRun]]("WorkflowRunPages")
+	}
+
+	return c.WorkflowRunPagesFunc(ctx, workflowID, opts, next)
+}
+
+// GetWorkflowRun records the call and returns the result of GetWorkflowRunFunc.
+func (c *Client) GetWorkflowRun(ctx context.Context, workflowID, runID string) (*retool.WorkflowRun, error) {
+	c.record("GetWorkflowRun", workflowID, runID)
+	if c.GetWorkflowRunFunc == nil {
+		return nil, notConfigured("GetWorkflowRun")
+	}
+
+	return c.GetWorkflowRunFunc(ctx, workflowID, runID)
+}
+
+// CancelWorkflowRun records the call and returns the result of CancelWorkflowRunFunc.
+func (c *Client) CancelWorkflowRun(ctx context.Context, workflowID, runID string) (*retool.WorkflowRun, error) {
+	c.record("CancelWorkflowRun", workflowID, runID)
+	if c.CancelWorkflowRunFunc == nil {
+		return nil, notConfigured("CancelWorkflowRun")
+	}
+
+	return c.CancelWorkflowRunFunc(ctx, workflowID, runID)
+}
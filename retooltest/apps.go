@@ -0,0 +1,126 @@
+package retooltest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+func (s *Server) registerApps(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v2/apps", s.handle(ScopeAppsRead, s.listApps))
+	mux.HandleFunc("GET /api/v2/apps/{id}", s.handle(ScopeAppsRead, s.getApp))
+	mux.HandleFunc("PATCH /api/v2/apps/{id}", s.handle(ScopeAppsWrite, s.updateApp))
+	mux.HandleFunc("DELETE /api/v2/apps/{id}", s.handle(ScopeAppsWrite, s.deleteApp))
+}
+
+// AddApp stores an app and returns it with its generated fields set. Apps cannot be created through the API.
+func (s *Server) AddApp(app retool.App) retool.App {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if app.ID == "" {
+		app.ID = s.newID("app")
+	}
+	if app.LegacyID == "" {
+		app.LegacyID = fmt.Sprint(s.newIntID())
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	if app.CreatedAt == "" {
+		app.CreatedAt = now
+	}
+	app.UpdatedAt = now
+
+	s.apps = append(s.apps, &app)
+	return app
+}
+
+// Apps returns the stored apps.
+func (s *Server) Apps() []retool.App {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.apps)
+}
+
+func (s *Server) findApp(id string) (*retool.App, int) {
+	return findByID(s.apps, id, func(a *retool.App) string { return a.ID })
+}
+
+func (s *Server) listApps(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var apps []retool.App
+	for _, app := range s.apps {
+		if folderID := query.Get("folder_id"); folderID != "" && app.FolderID != folderID {
+			continue
+		}
+		if name := query.Get("name"); name != "" && app.Name != name {
+			continue
+		}
+		apps = append(apps, *app)
+	}
+
+	paginate(s, w, r, apps)
+}
+
+func (s *Server) getApp(w http.ResponseWriter, r *http.Request) {
+	app, _ := s.findApp(r.PathValue("id"))
+	if app == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("App %s not found", r.PathValue("id")))
+		return
+	}
+
+	writeData(w, http.StatusOK, app)
+}
+
+func (s *Server) updateApp(w http.ResponseWriter, r *http.Request) {
+	app, _ := s.findApp(r.PathValue("id"))
+	if app == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("App %s not found", r.PathValue("id")))
+		return
+	}
+
+	operations, ok := decodeOperations(w, r)
+	if !ok {
+		return
+	}
+
+	updated := *app
+	if err := applyOperations(&updated, operations); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Patched document failed schema validation: %s", err))
+		return
+	}
+
+	if updated.FolderID != app.FolderID {
+		folder, _ := s.findFolder(updated.FolderID)
+		if folder == nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Folder %s not found", updated.FolderID))
+			return
+		}
+		if folder.FolderType != retool.FolderTypeApp {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Folder %s is not an app folder", updated.FolderID))
+			return
+		}
+	}
+
+	updated.ID = app.ID
+	updated.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	*app = updated
+	writeData(w, http.StatusOK, app)
+}
+
+func (s *Server) deleteApp(w http.ResponseWriter, r *http.Request) {
+	app, i := s.findApp(r.PathValue("id"))
+	if app == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("App %s not found", r.PathValue("id")))
+		return
+	}
+
+	s.apps = slices.Delete(s.apps, i, i+1)
+	s.revokeObject(retool.AppObject, app.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
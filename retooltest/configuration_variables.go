@@ -0,0 +1,129 @@
+package retooltest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+func (s *Server) registerConfigurationVariables(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v2/configuration_variables", s.handle(ScopeConfigurationVariablesRead, s.listConfigurationVariables))
+	mux.HandleFunc("POST /api/v2/configuration_variables", s.handle(ScopeConfigurationVariablesWrite, s.createConfigurationVariable))
+	mux.HandleFunc("GET /api/v2/configuration_variables/{id}", s.handle(ScopeConfigurationVariablesRead, s.getConfigurationVariable))
+	mux.HandleFunc("PUT /api/v2/configuration_variables/{id}", s.handle(ScopeConfigurationVariablesWrite, s.updateConfigurationVariable))
+	mux.HandleFunc("DELETE /api/v2/configuration_variables/{id}", s.handle(ScopeConfigurationVariablesWrite, s.deleteConfigurationVariable))
+}
+
+// AddConfigurationVariable stores a configuration variable and returns it with its generated fields set.
+func (s *Server) AddConfigurationVariable(variable retool.ConfigurationVariable) retool.ConfigurationVariable {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return *s.addConfigurationVariable(variable)
+}
+
+// ConfigurationVariables returns the stored configuration variables.
+func (s *Server) ConfigurationVariables() []retool.ConfigurationVariable {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.configurationVariables)
+}
+
+func (s *Server) addConfigurationVariable(variable retool.ConfigurationVariable) *retool.ConfigurationVariable {
+	if variable.Id == "" {
+		variable.Id = s.newID("config_var")
+	}
+
+	s.configurationVariables = append(s.configurationVariables, &variable)
+	return &variable
+}
+
+func (s *Server) findConfigurationVariable(id string) (*retool.ConfigurationVariable, int) {
+	return findByID(s.configurationVariables, id, func(v *retool.ConfigurationVariable) string { return v.Id })
+}
+
+// validateConfigurationVariable checks the variable and that its name is not used by another variable.
+func (s *Server) validateConfigurationVariable(variable retool.ConfigurationVariable, id string) (int, error) {
+	if variable.Name == "" {
+		return http.StatusBadRequest, fmt.Errorf("name is required")
+	}
+
+	for _, value := range variable.Values {
+		if value.EnvironmentId == "" {
+			return http.StatusBadRequest, fmt.Errorf("environment_id is required for every value")
+		}
+	}
+
+	for _, existing := range s.configurationVariables {
+		if existing.Name == variable.Name && existing.Id != id {
+			return http.StatusConflict, fmt.Errorf("Configuration variable with name %s already exists", variable.Name)
+		}
+	}
+
+	return 0, nil
+}
+
+func (s *Server) listConfigurationVariables(w http.ResponseWriter, r *http.Request) {
+	paginate(s, w, r, values(s.configurationVariables))
+}
+
+func (s *Server) getConfigurationVariable(w http.ResponseWriter, r *http.Request) {
+	variable, _ := s.findConfigurationVariable(r.PathValue("id"))
+	if variable == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Configuration variable %s not found", r.PathValue("id")))
+		return
+	}
+
+	writeData(w, http.StatusOK, variable)
+}
+
+func (s *Server) createConfigurationVariable(w http.ResponseWriter, r *http.Request) {
+	var variable retool.ConfigurationVariable
+	if !decodeBody(w, r, &variable) {
+		return
+	}
+
+	if status, err := s.validateConfigurationVariable(variable, ""); err != nil {
+		writeError(w, status, err.Error())
+		return
+	}
+
+	variable.Id = ""
+	writeData(w, http.StatusOK, s.addConfigurationVariable(variable))
+}
+
+func (s *Server) updateConfigurationVariable(w http.ResponseWriter, r *http.Request) {
+	existing, _ := s.findConfigurationVariable(r.PathValue("id"))
+	if existing == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Configuration variable %s not found", r.PathValue("id")))
+		return
+	}
+
+	var variable retool.ConfigurationVariable
+	if !decodeBody(w, r, &variable) {
+		return
+	}
+
+	if status, err := s.validateConfigurationVariable(variable, existing.Id); err != nil {
+		writeError(w, status, err.Error())
+		return
+	}
+
+	variable.Id = existing.Id
+	*existing = variable
+	writeData(w, http.StatusOK, existing)
+}
+
+func (s *Server) deleteConfigurationVariable(w http.ResponseWriter, r *http.Request) {
+	variable, i := s.findConfigurationVariable(r.PathValue("id"))
+	if variable == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Configuration variable %s not found", r.PathValue("id")))
+		return
+	}
+
+	s.configurationVariables = slices.Delete(s.configurationVariables, i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,144 @@
+package retooltest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+func (s *Server) registerEnvironments(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v2/environments", s.handle(ScopeEnvironmentsRead, s.listEnvironments))
+	mux.HandleFunc("POST /api/v2/environments", s.handle(ScopeEnvironmentsWrite, s.createEnvironment))
+	mux.HandleFunc("GET /api/v2/environments/{id}", s.handle(ScopeEnvironmentsRead, s.getEnvironment))
+	mux.HandleFunc("PATCH /api/v2/environments/{id}", s.handle(ScopeEnvironmentsWrite, s.updateEnvironment))
+	mux.HandleFunc("DELETE /api/v2/environments/{id}", s.handle(ScopeEnvironmentsWrite, s.deleteEnvironment))
+}
+
+// AddEnvironment stores an environment and returns it with its generated fields set.
+func (s *Server) AddEnvironment(environment retool.Environment) retool.Environment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return *s.addEnvironment(environment)
+}
+
+// Environments returns the stored environments.
+func (s *Server) Environments() []retool.Environment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.environments)
+}
+
+func (s *Server) addEnvironment(environment retool.Environment) *retool.Environment {
+	if environment.ID == "" {
+		environment.ID = s.newID("env")
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	if environment.CreatedAt == "" {
+		environment.CreatedAt = now
+	}
+	environment.UpdatedAt = now
+
+	s.environments = append(s.environments, &environment)
+	return &environment
+}
+
+func (s *Server) findEnvironment(id string) (*retool.Environment, int) {
+	return findByID(s.environments, id, func(e *retool.Environment) string { return e.ID })
+}
+
+// validateEnvironmentName checks that the name is set and not used by another environment.
+func (s *Server) validateEnvironmentName(name, id string) (int, error) {
+	if name == "" {
+		return http.StatusBadRequest, fmt.Errorf("name is required")
+	}
+
+	for _, existing := range s.environments {
+		if strings.EqualFold(existing.Name, name) && existing.ID != id {
+			return http.StatusConflict, fmt.Errorf("Environment with name %s already exists", name)
+		}
+	}
+
+	return 0, nil
+}
+
+func (s *Server) listEnvironments(w http.ResponseWriter, r *http.Request) {
+	paginate(s, w, r, values(s.environments))
+}
+
+func (s *Server) getEnvironment(w http.ResponseWriter, r *http.Request) {
+	environment, _ := s.findEnvironment(r.PathValue("id"))
+	if environment == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Environment %s not found", r.PathValue("id")))
+		return
+	}
+
+	writeData(w, http.StatusOK, environment)
+}
+
+func (s *Server) createEnvironment(w http.ResponseWriter, r *http.Request) {
+	var environment retool.Environment
+	if !decodeBody(w, r, &environment) {
+		return
+	}
+
+	if status, err := s.validateEnvironmentName(environment.Name, ""); err != nil {
+		writeError(w, status, err.Error())
+		return
+	}
+
+	environment.ID = ""
+	environment.Default = false
+	writeData(w, http.StatusOK, s.addEnvironment(environment))
+}
+
+func (s *Server) updateEnvironment(w http.ResponseWriter, r *http.Request) {
+	environment, _ := s.findEnvironment(r.PathValue("id"))
+	if environment == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Environment %s not found", r.PathValue("id")))
+		return
+	}
+
+	operations, ok := decodeOperations(w, r)
+	if !ok {
+		return
+	}
+
+	updated := *environment
+	if err := applyOperations(&updated, operations); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Patched document failed schema validation: %s", err))
+		return
+	}
+
+	if status, err := s.validateEnvironmentName(updated.Name, environment.ID); err != nil {
+		writeError(w, status, err.Error())
+		return
+	}
+
+	updated.ID = environment.ID
+	updated.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	*environment = updated
+	writeData(w, http.StatusOK, environment)
+}
+
+func (s *Server) deleteEnvironment(w http.ResponseWriter, r *http.Request) {
+	environment, i := s.findEnvironment(r.PathValue("id"))
+	if environment == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Environment %s not found", r.PathValue("id")))
+		return
+	}
+
+	if environment.Default {
+		writeError(w, http.StatusBadRequest, "The default environment cannot be deleted")
+		return
+	}
+
+	s.environments = slices.Delete(s.environments, i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,177 @@
+package retooltest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+func (s *Server) registerFolders(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v2/folders", s.handle(ScopeFoldersRead, s.listFolders))
+	mux.HandleFunc("POST /api/v2/folders", s.handle(ScopeFoldersWrite, s.createFolder))
+	mux.HandleFunc("GET /api/v2/folders/{id}", s.handle(ScopeFoldersRead, s.getFolder))
+	mux.HandleFunc("PATCH /api/v2/folders/{id}", s.handle(ScopeFoldersWrite, s.updateFolder))
+	mux.HandleFunc("DELETE /api/v2/folders/{id}", s.handle(ScopeFoldersWrite, s.deleteFolder))
+}
+
+// AddFolder stores a folder and returns it with its generated fields set.
+func (s *Server) AddFolder(folder retool.Folder) retool.Folder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return *s.addFolder(folder)
+}
+
+// Folders returns the stored folders.
+func (s *Server) Folders() []retool.Folder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.folders)
+}
+
+func (s *Server) addFolder(folder retool.Folder) *retool.Folder {
+	if folder.ID == "" {
+		folder.ID = s.newID("folder")
+	}
+	if folder.LegacyID == "" {
+		folder.LegacyID = fmt.Sprint(s.newIntID())
+	}
+	if folder.FolderType == "" {
+		folder.FolderType = retool.FolderTypeApp
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	if folder.CreatedAt == "" {
+		folder.CreatedAt = now
+	}
+	folder.UpdatedAt = now
+
+	s.folders = append(s.folders, &folder)
+	return &folder
+}
+
+func (s *Server) findFolder(id string) (*retool.Folder, int) {
+	return findByID(s.folders, id, func(f *retool.Folder) string { return f.ID })
+}
+
+func (s *Server) listFolders(w http.ResponseWriter, r *http.Request) {
+	paginate(s, w, r, values(s.folders))
+}
+
+func (s *Server) getFolder(w http.ResponseWriter, r *http.Request) {
+	folder, _ := s.findFolder(r.PathValue("id"))
+	if folder == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Folder %s not found", r.PathValue("id")))
+		return
+	}
+
+	writeData(w, http.StatusOK, folder)
+}
+
+func (s *Server) createFolder(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name           string `json:"name"`
+		ParentFolderID string `json:"parent_folder_id"`
+		FolderType     string `json:"folder_type"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	folderType := retool.FolderType(body.FolderType)
+	if body.FolderType == "" {
+		writeError(w, http.StatusBadRequest, "folder_type is required")
+		return
+	}
+	if err := folderType.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if body.ParentFolderID != "" {
+		parent, _ := s.findFolder(body.ParentFolderID)
+		if parent == nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Parent folder %s not found", body.ParentFolderID))
+			return
+		}
+		if parent.FolderType != body.FolderType {
+			writeError(w, http.StatusBadRequest, "Parent folder must have the same folder_type")
+			return
+		}
+	}
+
+	folder := s.addFolder(retool.Folder{
+		Name:           body.Name,
+		ParentFolderID: body.ParentFolderID,
+		FolderType:     body.FolderType,
+	})
+	writeData(w, http.StatusOK, folder)
+}
+
+func (s *Server) updateFolder(w http.ResponseWriter, r *http.Request) {
+	folder, _ := s.findFolder(r.PathValue("id"))
+	if folder == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Folder %s not found", r.PathValue("id")))
+		return
+	}
+
+	if folder.IsSystemFolder {
+		writeError(w, http.StatusBadRequest, "System folders cannot be updated")
+		return
+	}
+
+	operations, ok := decodeOperations(w, r)
+	if !ok {
+		return
+	}
+
+	updated := *folder
+	if err := applyOperations(&updated, operations); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Patched document failed schema validation: %s", err))
+		return
+	}
+
+	if updated.Name == "" {
+		writeError(w, http.StatusBadRequest, "Patched document failed schema validation: name is required")
+		return
+	}
+
+	updated.ID = folder.ID
+	updated.FolderType = folder.FolderType
+	updated.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	*folder = updated
+	writeData(w, http.StatusOK, folder)
+}
+
+func (s *Server) deleteFolder(w http.ResponseWriter, r *http.Request) {
+	folder, i := s.findFolder(r.PathValue("id"))
+	if folder == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Folder %s not found", r.PathValue("id")))
+		return
+	}
+
+	if folder.IsSystemFolder {
+		writeError(w, http.StatusBadRequest, "System folders cannot be deleted")
+		return
+	}
+
+	for _, child := range s.folders {
+		if child.ParentFolderID == folder.ID {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Folder %s is not empty", folder.ID))
+			return
+		}
+	}
+
+	s.folders = slices.Delete(s.folders, i, i+1)
+	s.revokeObject(retool.FolderObject, folder.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,230 @@
+package retooltest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+func (s *Server) registerGroups(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v2/groups", s.handle(ScopeGroupsRead, s.listGroups))
+	mux.HandleFunc("POST /api/v2/groups", s.handle(ScopeGroupsWrite, s.createGroup))
+	mux.HandleFunc("GET /api/v2/groups/{id}", s.handle(ScopeGroupsRead, s.getGroup))
+	mux.HandleFunc("PATCH /api/v2/groups/{id}", s.handle(ScopeGroupsWrite, s.updateGroup))
+	mux.HandleFunc("DELETE /api/v2/groups/{id}", s.handle(ScopeGroupsWrite, s.deleteGroup))
+	mux.HandleFunc("POST /api/v2/groups/{id}/members", s.handle(ScopeGroupsWrite, s.addGroupMembers))
+	mux.HandleFunc("DELETE /api/v2/groups/{id}/members/{userId}", s.handle(ScopeGroupsWrite, s.removeGroupMember))
+}
+
+// AddGroup stores a group and returns it with its generated fields set.
+func (s *Server) AddGroup(group retool.Group) retool.Group {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return *s.addGroup(group)
+}
+
+// Groups returns the stored groups.
+func (s *Server) Groups() []retool.Group {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.groups)
+}
+
+func (s *Server) addGroup(group retool.Group) *retool.Group {
+	if group.ID == 0 {
+		group.ID = s.newIntID()
+	}
+	if group.LegacyID == 0 {
+		group.LegacyID = group.ID
+	}
+	if group.UniversalAppAccess == "" {
+		group.UniversalAppAccess = retool.NoneAccess
+	}
+	if group.UniversalResourceAccess == "" {
+		group.UniversalResourceAccess = retool.NoneAccess
+	}
+	if group.UniversalWorkflowAccess == "" {
+		group.UniversalWorkflowAccess = retool.NoneAccess
+	}
+	if group.UniversalQueryLibraryAccess == "" {
+		group.UniversalQueryLibraryAccess = retool.NoneAccess
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	if group.CreatedAt == "" {
+		group.CreatedAt = now
+	}
+	group.UpdatedAt = now
+
+	s.groups = append(s.groups, &group)
+	return &group
+}
+
+func (s *Server) findGroup(id string) (*retool.Group, int) {
+	return findByID(s.groups, id, func(g *retool.Group) string { return strconv.Itoa(g.ID) })
+}
+
+// resolveMembers fills in the email of every member and checks that the users exist.
+func (s *Server) resolveMembers(members []retool.Member) error {
+	for i, member := range members {
+		user, _ := s.findUser(member.ID)
+		if user == nil {
+			return fmt.Errorf("No user '%s' found for organization", member.ID)
+		}
+		members[i].Email = user.Email
+	}
+
+	return nil
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) {
+	paginate(s, w, r, values(s.groups))
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request) {
+	group, _ := s.findGroup(r.PathValue("id"))
+	if group == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Group %s not found", r.PathValue("id")))
+		return
+	}
+
+	writeData(w, http.StatusOK, group)
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	var group retool.Group
+	if !decodeBody(w, r, &group) {
+		return
+	}
+
+	if group.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	if err := group.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	for _, existing := range s.groups {
+		if existing.Name == group.Name {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Group with name %s already exists", group.Name))
+			return
+		}
+	}
+
+	if err := s.resolveMembers(group.Members); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	group.ID = 0
+	group.UserInvites = nil
+	writeData(w, http.StatusOK, s.addGroup(group))
+}
+
+func (s *Server) updateGroup(w http.ResponseWriter, r *http.Request) {
+	group, _ := s.findGroup(r.PathValue("id"))
+	if group == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Group %s not found", r.PathValue("id")))
+		return
+	}
+
+	operations, ok := decodeOperations(w, r)
+	if !ok {
+		return
+	}
+
+	updated := *group
+	if err := applyOperations(&updated, operations); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Patched document failed schema validation: %s", err))
+		return
+	}
+
+	if updated.Name == "" {
+		writeError(w, http.StatusBadRequest, "Patched document failed schema validation: name is required")
+		return
+	}
+
+	if err := updated.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Patched document failed schema validation: %s", err))
+		return
+	}
+
+	updated.ID = group.ID
+	updated.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	*group = updated
+	writeData(w, http.StatusOK, group)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	group, i := s.findGroup(r.PathValue("id"))
+	if group == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Group %s not found", r.PathValue("id")))
+		return
+	}
+
+	s.groups = slices.Delete(s.groups, i, i+1)
+	s.revokeSubject("group", strconv.Itoa(group.ID))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) addGroupMembers(w http.ResponseWriter, r *http.Request) {
+	group, _ := s.findGroup(r.PathValue("id"))
+	if group == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Group %s not found", r.PathValue("id")))
+		return
+	}
+
+	var body struct {
+		Members []retool.Member `json:"members"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if len(body.Members) == 0 {
+		writeError(w, http.StatusBadRequest, "No members provided")
+		return
+	}
+
+	if err := s.resolveMembers(body.Members); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	for _, member := range body.Members {
+		i := slices.IndexFunc(group.Members, func(m retool.Member) bool { return m.ID == member.ID })
+		if i >= 0 {
+			group.Members[i] = member
+		} else {
+			group.Members = append(group.Members, member)
+		}
+	}
+
+	writeData(w, http.StatusOK, group)
+}
+
+func (s *Server) removeGroupMember(w http.ResponseWriter, r *http.Request) {
+	group, _ := s.findGroup(r.PathValue("id"))
+	if group == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Group %s not found", r.PathValue("id")))
+		return
+	}
+
+	i := slices.IndexFunc(group.Members, func(m retool.Member) bool { return m.ID == r.PathValue("userId") })
+	if i < 0 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("User '%s' is not a member of group %d", r.PathValue("userId"), group.ID))
+		return
+	}
+
+	group.Members = slices.Delete(group.Members, i, i+1)
+	writeData(w, http.StatusOK, group)
+}
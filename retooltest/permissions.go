@@ -0,0 +1,207 @@
+package retooltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// grant is an access level given to a subject (group or user) on an object.
+type grant struct {
+	subjectType string
+	subjectID   string
+	objectType  string
+	objectID    string
+	accessLevel string
+}
+
+// permissionRef identifies a subject or an object in permission request bodies.
+// Group IDs are numbers and all other IDs are strings, so the ID is kept raw.
+type permissionRef struct {
+	ID   json.RawMessage `json:"id"`
+	Type string          `json:"type"`
+}
+
+// id returns the ID as a string, whether it was sent as a number or a string.
+func (p permissionRef) id() string {
+	return strings.Trim(string(p.ID), `"`)
+}
+
+func (s *Server) registerPermissions(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v2/permissions/listObjects", s.handle(ScopePermissionsRead, s.listObjects))
+	mux.HandleFunc("POST /api/v2/permissions/grant", s.handle(ScopePermissionsWrite, s.grantPermission))
+	mux.HandleFunc("POST /api/v2/permissions/revoke", s.handle(ScopePermissionsWrite, s.revokePermission))
+	mux.HandleFunc("GET /api/v2/permissions/accessList/{objectType}/{objectId}", s.handle(ScopePermissionsRead, s.accessList))
+}
+
+// GrantPermission gives the subject ("group" or "user") the access level on an object, replacing any previous grant.
+func (s *Server) GrantPermission(subjectType, subjectID string, objectType retool.ObjectType, objectID string, accessLevel retool.AccessLevel) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.grant(subjectType, subjectID, string(objectType), objectID, string(accessLevel))
+}
+
+func (s *Server) grant(subjectType, subjectID, objectType, objectID, accessLevel string) {
+	s.revoke(subjectType, subjectID, objectType, objectID)
+	s.grants = append(s.grants, &grant{
+		subjectType: subjectType,
+		subjectID:   subjectID,
+		objectType:  objectType,
+		objectID:    objectID,
+		accessLevel: accessLevel,
+	})
+}
+
+func (s *Server) revoke(subjectType, subjectID, objectType, objectID string) {
+	s.grants = slices.DeleteFunc(s.grants, func(g *grant) bool {
+		return g.subjectType == subjectType && g.subjectID == subjectID &&
+			g.objectType == objectType && g.objectID == objectID
+	})
+}
+
+// revokeSubject removes every grant of a deleted subject.
+func (s *Server) revokeSubject(subjectType, subjectID string) {
+	s.grants = slices.DeleteFunc(s.grants, func(g *grant) bool {
+		return g.subjectType == subjectType && g.subjectID == subjectID
+	})
+}
+
+// revokeObject removes every grant on a deleted object.
+func (s *Server) revokeObject(objectType, objectID string) {
+	s.grants = slices.DeleteFunc(s.grants, func(g *grant) bool {
+		return g.objectType == objectType && g.objectID == objectID
+	})
+}
+
+// objects returns the objects of the type that the subject has access to.
+func (s *Server) objects(subjectType, subjectID, objectType string) []retool.Subject {
+	objects := []retool.Subject{}
+	for _, g := range s.grants {
+		if g.subjectType == subjectType && g.subjectID == subjectID && g.objectType == objectType {
+			objects = append(objects, retool.Subject{ID: g.objectID, Type: g.objectType, AccessLevel: g.accessLevel})
+		}
+	}
+
+	return objects
+}
+
+// validateSubject checks that the subject exists.
+func (s *Server) validateSubject(subject permissionRef) error {
+	switch subject.Type {
+	case "group":
+		if group, _ := s.findGroup(subject.id()); group == nil {
+			return fmt.Errorf("Group %s not found", subject.id())
+		}
+	case "user":
+		if user, _ := s.findUser(subject.id()); user == nil {
+			return fmt.Errorf("No user '%s' found for organization", subject.id())
+		}
+	default:
+		return fmt.Errorf("invalid subject type: %s", subject.Type)
+	}
+
+	return nil
+}
+
+// permissionBody is the request body for listing, granting and revoking permissions.
+type permissionBody struct {
+	Subject     permissionRef     `json:"subject"`
+	Object      permissionRef     `json:"object"`
+	ObjectType  retool.ObjectType `json:"objectType"`
+	AccessLevel string            `json:"access_level"`
+}
+
+// decodePermission decodes and validates a permission request body, with the object type taken from objectType.
+func (s *Server) decodePermission(w http.ResponseWriter, r *http.Request, objectType func(permissionBody) retool.ObjectType) (permissionBody, bool) {
+	var body permissionBody
+	if !decodeBody(w, r, &body) {
+		return body, false
+	}
+
+	if err := s.validateSubject(body.Subject); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return body, false
+	}
+
+	t := objectType(body)
+	if err := t.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return body, false
+	}
+
+	return body, true
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request) {
+	body, ok := s.decodePermission(w, r, func(b permissionBody) retool.ObjectType { return b.ObjectType })
+	if !ok {
+		return
+	}
+
+	paginate(s, w, r, s.objects(body.Subject.Type, body.Subject.id(), string(body.ObjectType)))
+}
+
+func (s *Server) grantPermission(w http.ResponseWriter, r *http.Request) {
+	body, ok := s.decodePermission(w, r, func(b permissionBody) retool.ObjectType { return retool.ObjectType(b.Object.Type) })
+	if !ok {
+		return
+	}
+
+	accessLevel := retool.AccessLevel(body.AccessLevel)
+	if err := accessLevel.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.grant(body.Subject.Type, body.Subject.id(), body.Object.Type, body.Object.id(), body.AccessLevel)
+	paginate(s, w, r, s.objects(body.Subject.Type, body.Subject.id(), body.Object.Type))
+}
+
+func (s *Server) revokePermission(w http.ResponseWriter, r *http.Request) {
+	body, ok := s.decodePermission(w, r, func(b permissionBody) retool.ObjectType { return retool.ObjectType(b.Object.Type) })
+	if !ok {
+		return
+	}
+
+	s.revoke(body.Subject.Type, body.Subject.id(), body.Object.Type, body.Object.id())
+	paginate(s, w, r, s.objects(body.Subject.Type, body.Subject.id(), body.Object.Type))
+}
+
+func (s *Server) accessList(w http.ResponseWriter, r *http.Request) {
+	objectType := retool.ObjectType(r.PathValue("objectType"))
+	if err := objectType.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data := retool.GroupedData{
+		Group:      []retool.AccessData{},
+		User:       []retool.AccessData{},
+		UserInvite: []retool.AccessData{},
+	}
+
+	for _, g := range s.grants {
+		if g.objectType != string(objectType) || g.objectID != r.PathValue("objectId") {
+			continue
+		}
+
+		access := retool.AccessData{
+			Subject:     retool.Subject{ID: g.subjectID, Type: g.subjectType},
+			Sources:     retool.Sources{Direct: true},
+			AccessLevel: g.accessLevel,
+		}
+
+		if g.subjectType == "group" {
+			data.Group = append(data.Group, access)
+		} else {
+			data.User = append(data.User, access)
+		}
+	}
+
+	writeData(w, http.StatusOK, data)
+}
@@ -0,0 +1,376 @@
+// Package retooltest provides an in-memory fake of the Retool API v2 for testing code built on retoolsdk.
+//
+// The fake server keeps users, user invites, groups, folders, apps, spaces, environments, configuration variables,
+// user attributes and permissions in memory, paginates listings with has_more and next_token, validates request
+// bodies and checks the scopes of the API token used for every request.
+package retooltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// DefaultAPIKey is the API token accepted by the server with every scope.
+const DefaultAPIKey = "retooltest-api-key"
+
+// DefaultPageSize is the number of items returned per page by default.
+const DefaultPageSize = 100
+
+// Scopes of the API tokens accepted by the server.
+const (
+	ScopeUsersRead                   = "users:read"
+	ScopeUsersWrite                  = "users:write"
+	ScopeGroupsRead                  = "groups:read"
+	ScopeGroupsWrite                 = "groups:write"
+	ScopeFoldersRead                 = "folders:read"
+	ScopeFoldersWrite                = "folders:write"
+	ScopeSpacesRead                  = "spaces:read"
+	ScopeSpacesWrite                 = "spaces:write"
+	ScopeConfigurationVariablesRead  = "configuration_variables:read"
+	ScopeConfigurationVariablesWrite = "configuration_variables:write"
+	ScopePermissionsRead             = "permissions:read"
+	ScopePermissionsWrite            = "permissions:write"
+	ScopeAppsRead                    = "apps:read"
+	ScopeAppsWrite                   = "apps:write"
+	ScopeEnvironmentsRead            = "environments:read"
+	ScopeEnvironmentsWrite           = "environments:write"
+)
+
+// AllScopes lists every scope supported by the server.
+var AllScopes = []string{
+	ScopeUsersRead, ScopeUsersWrite,
+	ScopeGroupsRead, ScopeGroupsWrite,
+	ScopeFoldersRead, ScopeFoldersWrite,
+	ScopeSpacesRead, ScopeSpacesWrite,
+	ScopeConfigurationVariablesRead, ScopeConfigurationVariablesWrite,
+	ScopePermissionsRead, ScopePermissionsWrite,
+	ScopeAppsRead, ScopeAppsWrite,
+	ScopeEnvironmentsRead, ScopeEnvironmentsWrite,
+}
+
+// scopeNames maps scopes to the names used by Retool in error messages.
+var scopeNames = map[string]string{
+	ScopeUsersRead:                   "Users > Read",
+	ScopeUsersWrite:                  "Users > Write",
+	ScopeGroupsRead:                  "Groups > Read",
+	ScopeGroupsWrite:                 "Groups > Write",
+	ScopeFoldersRead:                 "Folders > Read",
+	ScopeFoldersWrite:                "Folders > Write",
+	ScopeSpacesRead:                  "Spaces > Read",
+	ScopeSpacesWrite:                 "Spaces > Write",
+	ScopeConfigurationVariablesRead:  "Configuration Variables > Read",
+	ScopeConfigurationVariablesWrite: "Configuration Variables > Write",
+	ScopePermissionsRead:             "Permissions > Read",
+	ScopePermissionsWrite:            "Permissions > Write",
+	ScopeAppsRead:                    "Apps > Read",
+	ScopeAppsWrite:                   "Apps > Write",
+	ScopeEnvironmentsRead:            "Environments > Read",
+	ScopeEnvironmentsWrite:           "Environments > Write",
+}
+
+// Server is a stateful fake of the Retool API v2 running on an httptest.Server.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	pageSize int
+	version  string
+	tokens   map[string][]string
+	nextID   int
+
+	users                  []*retool.User
+	groups                 []*retool.Group
+	userInvites            []*retool.UserInvite
+	folders                []*retool.Folder
+	spaces                 []*retool.Space
+	configurationVariables []*retool.ConfigurationVariable
+	organizationAttributes []*retool.OrganizationAttribute
+	apps                   []*retool.App
+	environments           []*retool.Environment
+	grants                 []*grant
+}
+
+// Option defines the type for functional options of the server.
+type Option func(*Server)
+
+// WithPageSize sets the number of items returned per page.
+func WithPageSize(size int) Option {
+	return func(s *Server) {
+		if size > 0 {
+			s.pageSize = size
+		}
+	}
+}
+
+// WithToken registers an additional API token granted only the given scopes.
+func WithToken(apiKey string, scopes ...string) Option {
+	return func(s *Server) {
+		s.tokens[apiKey] = scopes
+	}
+}
+
+// WithVersion sets the version reported by the health check endpoint, used by retoolsdk feature detection.
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+// NewServer starts a new fake Retool server. The caller must call Close when finished.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		pageSize: DefaultPageSize,
+		tokens:   map[string][]string{DefaultAPIKey: AllScopes},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/checkHealth", s.handleHealth)
+	s.registerUsers(mux)
+	s.registerUserInvites(mux)
+	s.registerGroups(mux)
+	s.registerFolders(mux)
+	s.registerSpaces(mux)
+	s.registerConfigurationVariables(mux)
+	s.registerPermissions(mux)
+	s.registerApps(mux)
+	s.registerEnvironments(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Route %s %s not found", r.Method, r.URL.Path))
+	})
+
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Client returns a retoolsdk client for the server authenticated with DefaultAPIKey.
+func (s *Server) Client(opts ...retool.ClientOption) (*retool.Client, error) {
+	return retool.NewClient(DefaultAPIKey, s.URL, opts...)
+}
+
+// newID returns a unique identifier with the given prefix.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s_%032x", prefix, s.nextID)
+}
+
+// newIntID returns a unique numeric identifier.
+func (s *Server) newIntID() int {
+	s.nextID++
+	return s.nextID
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "HEALTHY", "version": s.version})
+}
+
+// authorize checks the API token of the request for the scope and writes an error response when it is missing.
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request, scope string) bool {
+	apiKey, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "Missing API token")
+		return false
+	}
+
+	scopes, ok := s.tokens[apiKey]
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "Invalid API token")
+		return false
+	}
+
+	if !slices.Contains(scopes, scope) {
+		writeError(w, http.StatusForbidden, fmt.Sprintf("API token does not have the %q scope", scopeNames[scope]))
+		return false
+	}
+
+	return true
+}
+
+// handle wraps a handler with the scope check and the server lock.
+func (s *Server) handle(scope string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if !s.authorize(w, r, scope) {
+			return
+		}
+
+		handler(w, r)
+	}
+}
+
+// paginate writes the page of items selected by the next query parameter.
+func paginate[T any](s *Server, w http.ResponseWriter, r *http.Request, items []T) {
+	offset := 0
+
+	if next := r.URL.Query().Get("next"); next != "" {
+		n, err := strconv.Atoi(strings.TrimPrefix(next, "offset_"))
+		if err != nil || n < 0 || n > len(items) {
+			writeError(w, http.StatusBadRequest, "Invalid pagination token")
+			return
+		}
+		offset = n
+	}
+
+	end := min(offset+s.pageSize, len(items))
+
+	response := retool.Response[[]T]{
+		Success:    true,
+		Data:       items[offset:end],
+		TotalCount: len(items),
+		HasMore:    end < len(items),
+	}
+
+	if response.HasMore {
+		response.NextToken = fmt.Sprintf("offset_%d", end)
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// decodeBody decodes the JSON request body into v and writes an error response when it is invalid.
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+		return false
+	}
+
+	return true
+}
+
+// applyOperations applies JSON Patch style operations to a copy of v and decodes the result into v.
+// Values are converted to the kind of the struct field they replace.
+func applyOperations(v interface{}, operations []retool.UpdateOperations) error {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal(encoded, &document); err != nil {
+		return err
+	}
+
+	kinds := fieldKinds(reflect.TypeOf(v).Elem())
+
+	for _, op := range operations {
+		if err := op.Validate(); err != nil {
+			return err
+		}
+
+		path := strings.TrimPrefix(op.Path, "/")
+
+		if op.Op == retool.OpRemove {
+			delete(document, path)
+			continue
+		}
+
+		switch kinds[path] {
+		case reflect.Bool:
+			value, err := strconv.ParseBool(op.Value)
+			if err != nil {
+				return fmt.Errorf("invalid boolean value for %s: %s", path, op.Value)
+			}
+			document[path] = value
+		case reflect.Int:
+			value, err := strconv.Atoi(op.Value)
+			if err != nil {
+				return fmt.Errorf("invalid number value for %s: %s", path, op.Value)
+			}
+			document[path] = value
+		default:
+			document[path] = op.Value
+		}
+	}
+
+	encoded, err = json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(encoded, v)
+}
+
+// fieldKinds maps the JSON names of the fields of a struct type to their kinds.
+func fieldKinds(t reflect.Type) map[string]reflect.Kind {
+	kinds := make(map[string]reflect.Kind, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" {
+			name = field.Name
+		}
+		kinds[name] = field.Type.Kind()
+	}
+
+	return kinds
+}
+
+// decodeOperations decodes a request body holding JSON Patch style operations.
+func decodeOperations(w http.ResponseWriter, r *http.Request) ([]retool.UpdateOperations, bool) {
+	var body struct {
+		Operations []retool.UpdateOperations `json:"operations"`
+	}
+
+	if !decodeBody(w, r, &body) {
+		return nil, false
+	}
+
+	if len(body.Operations) == 0 {
+		writeError(w, http.StatusBadRequest, "No operations provided")
+		return nil, false
+	}
+
+	return body.Operations, true
+}
+
+// writeData writes a successful response holding data.
+func writeData(w http.ResponseWriter, status int, data interface{}) {
+	writeJSON(w, status, retool.Response[interface{}]{Success: true, Data: data})
+}
+
+// writeError writes an unsuccessful response with the message.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, retool.Response[interface{}]{Success: false, Message: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// findByID returns the item with the given ID and its index, or -1 when there is none.
+func findByID[T any](items []*T, id string, idOf func(*T) string) (*T, int) {
+	for i, item := range items {
+		if idOf(item) == id {
+			return item, i
+		}
+	}
+
+	return nil, -1
+}
+
+// values returns copies of the stored items.
+func values[T any](items []*T) []T {
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		result = append(result, *item)
+	}
+
+	return result
+}
@@ -0,0 +1,343 @@
+package retooltest_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+	"github.com/thoughtgears/retoolsdk/retooltest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newClient(t *testing.T, server *retooltest.Server, opts ...retool.ClientOption) *retool.Client {
+	t.Helper()
+
+	client, err := server.Client(opts...)
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	return client
+}
+
+func TestServer_Users(t *testing.T) {
+	server := retooltest.NewServer()
+	defer server.Close()
+
+	client := newClient(t, server)
+	ctx := context.Background()
+
+	user, err := client.CreateUser(ctx, "jane@example.com", "Jane", "Doe", &retool.CreateUserOpts{Active: true, Type: retool.UserTypeDefault})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, user.ID)
+	assert.True(t, user.Active)
+
+	_, err = client.CreateUser(ctx, "jane@example.com", "Jane", "Doe", nil)
+	assert.ErrorIs(t, err, retool.ErrConflict)
+
+	got, err := client.GetUser(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, user, got)
+
+	updated, err := client.UpdateUser(ctx, user.ID, []retool.UpdateOperations{
+		{Op: retool.OpReplace, Path: "/first_name", Value: "Janet"},
+		{Op: retool.OpReplace, Path: "/is_admin", Value: "true"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Janet", updated.FirstName)
+	assert.True(t, updated.IsAdmin)
+
+	users, err := client.ListUsers(ctx, &retool.ListUserOpts{Email: "jane@example.com"})
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+
+	attributes, err := client.UpdateUserAttributes(ctx, user.ID, []retool.UserAttribute{{Name: "team", Value: "platform"}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"team": "platform"}, attributes)
+
+	_, err = client.DeleteUserAttribute(ctx, user.ID, "team")
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.DeleteUser(ctx, user.ID))
+	assert.False(t, server.Users()[0].Active)
+
+	_, err = client.GetUser(ctx, "user_missing")
+	assert.ErrorIs(t, err, retool.ErrNotFound)
+}
+
+func TestServer_Pagination(t *testing.T) {
+	server := retooltest.NewServer(retooltest.WithPageSize(2))
+	defer server.Close()
+
+	for i := range 5 {
+		server.AddUser(retool.User{Email: "user" + strconv.Itoa(i) + "@example.com", FirstName: "User", LastName: strconv.Itoa(i)})
+	}
+
+	client := newClient(t, server)
+
+	users, err := client.ListUsers(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, users, 5)
+
+	var pages int
+	for page, err := range client.UserPages(context.Background(), nil, "") {
+		assert.NoError(t, err)
+		assert.Equal(t, 5, page.TotalCount)
+		pages++
+	}
+	assert.Equal(t, 3, pages)
+}
+
+func TestServer_Groups(t *testing.T) {
+	server := retooltest.NewServer()
+	defer server.Close()
+
+	user := server.AddUser(retool.User{Email: "jane@example.com", FirstName: "Jane", LastName: "Doe"})
+	client := newClient(t, server)
+	ctx := context.Background()
+
+	group, err := client.CreateGroup(ctx, &retool.Group{Name: "Engineering", UniversalAppAccess: retool.EditAccess})
+	assert.NoError(t, err)
+	id := strconv.Itoa(group.ID)
+
+	_, err = client.CreateGroup(ctx, &retool.Group{UniversalAppAccess: retool.EditAccess})
+	assert.ErrorIs(t, err, retool.ErrBadRequest)
+
+	group, err = client.AddUsersToGroup(ctx, id, []retool.Member{{ID: user.ID, IsGroupAdmin: true}})
+	assert.NoError(t, err)
+	assert.Equal(t, []retool.Member{{ID: user.ID, Email: user.Email, IsGroupAdmin: true}}, group.Members)
+
+	group, err = client.RemoveUserFromGroup(ctx, id, user.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, group.Members)
+
+	group, err = client.UpdateGroup(ctx, id, []retool.UpdateOperations{{Op: retool.OpReplace, Path: "/name", Value: "Platform"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Platform", group.Name)
+
+	assert.NoError(t, client.DeleteGroup(ctx, id))
+	assert.Empty(t, server.Groups())
+}
+
+func TestServer_FoldersAndSpaces(t *testing.T) {
+	server := retooltest.NewServer()
+	defer server.Close()
+
+	client := newClient(t, server)
+	ctx := context.Background()
+
+	folder, err := client.CreateFolder(ctx, "Apps", "", retool.FolderTypeApp)
+	assert.NoError(t, err)
+	assert.Equal(t, retool.FolderTypeApp, folder.FolderType)
+
+	_, err = client.CreateFolder(ctx, "Nested", "folder_missing", retool.FolderTypeApp)
+	assert.ErrorIs(t, err, retool.ErrBadRequest)
+
+	folders, err := client.ListFolders(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, folders, 1)
+
+	assert.NoError(t, client.DeleteFolder(ctx, folder.ID))
+
+	space, err := client.CreateSpace(ctx, "Staging", "staging.example.com", nil)
+	assert.NoError(t, err)
+
+	space, err = client.UpdateSpace(ctx, space.ID, "Staging", "stage.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "stage.example.com", space.Domain)
+
+	_, err = client.CreateSpace(ctx, "Copy", "stage.example.com", nil)
+	assert.ErrorIs(t, err, retool.ErrConflict)
+
+	assert.NoError(t, client.DeleteSpace(ctx, space.ID))
+	assert.Empty(t, server.Spaces())
+}
+
+func TestServer_ConfigurationVariables(t *testing.T) {
+	server := retooltest.NewServer()
+	defer server.Close()
+
+	client := newClient(t, server)
+	ctx := context.Background()
+
+	values := []retool.Value{{EnvironmentId: "production", Value: "https://api.example.com"}}
+	variable, err := client.CreateConfigurationVariable(ctx, "API_URL", "Base URL", false, values)
+	assert.NoError(t, err)
+	assert.Equal(t, values, variable.Values)
+
+	_, err = client.CreateConfigurationVariable(ctx, "API_URL", "", false, nil)
+	assert.ErrorIs(t, err, retool.ErrConflict)
+
+	variable, err = client.UpdateConfigurationVariable(ctx, variable.Id, "API_URL", "Updated", true, values)
+	assert.NoError(t, err)
+	assert.True(t, variable.Secret)
+
+	assert.NoError(t, client.DeleteConfigurationVariable(ctx, variable.Id))
+	assert.Empty(t, server.ConfigurationVariables())
+}
+
+func TestServer_Permissions(t *testing.T) {
+	server := retooltest.NewServer()
+	defer server.Close()
+
+	group := server.AddGroup(retool.Group{Name: "Engineering"})
+	folder := server.AddFolder(retool.Folder{Name: "Apps"})
+	client := newClient(t, server)
+	ctx := context.Background()
+
+	objects, err := client.GrantPermission(ctx, "group", group.ID, retool.FolderObject, folder.ID, retool.EditAccess)
+	assert.NoError(t, err)
+	assert.Equal(t, []retool.Subject{{ID: folder.ID, Type: retool.FolderObject, AccessLevel: retool.EditAccess}}, objects)
+
+	objects, err = client.ListGroupObjectPermissions(ctx, "group", retool.FolderObject, group.ID)
+	assert.NoError(t, err)
+	assert.Len(t, objects, 1)
+
+	accessList, err := client.GetFolderOrAppAccessList(ctx, retool.ObjectType(folder.ID), retool.FolderObject)
+	assert.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(group.ID), accessList.Group[0].Subject.ID)
+
+	_, err = client.GrantPermission(ctx, "group", group.ID, retool.FolderObject, folder.ID, "admin")
+	assert.ErrorIs(t, err, retool.ErrBadRequest)
+
+	objects, err = client.RevokePermission(ctx, "group", group.ID, retool.FolderObject, folder.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestServer_Scopes(t *testing.T) {
+	server := retooltest.NewServer(retooltest.WithToken("read-only", retooltest.ScopeUsersRead))
+	defer server.Close()
+
+	client, err := retool.NewClient("read-only", server.URL)
+	assert.NoError(t, err)
+
+	_, err = client.ListUsers(context.Background(), nil)
+	assert.NoError(t, err)
+
+	_, err = client.CreateUser(context.Background(), "jane@example.com", "Jane", "Doe", nil)
+	assert.ErrorIs(t, err, retool.ErrForbidden)
+	assert.Contains(t, err.Error(), "Users > Write")
+
+	_, err = client.ListGroups(context.Background())
+	assert.ErrorIs(t, err, retool.ErrForbidden)
+
+	client, err = retool.NewClient("unknown", server.URL)
+	assert.NoError(t, err)
+
+	_, err = client.ListUsers(context.Background(), nil)
+	assert.ErrorIs(t, err, retool.ErrUnauthorized)
+
+	var apiErr *retool.APIError
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
+
+func TestServer_Version(t *testing.T) {
+	server := retooltest.NewServer(retooltest.WithVersion("3.40.0-stable"))
+	defer server.Close()
+
+	client := newClient(t, server, retool.WithFeatureDetection())
+
+	info, err := client.ServerInfo(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "3.40.0-stable", info.Version)
+
+	_, err = client.ListConfigurationVariables(context.Background())
+	assert.ErrorIs(t, err, retool.ErrUnsupportedByServer)
+}
+
+func TestServer_Apps(t *testing.T) {
+	server := retooltest.NewServer()
+	defer server.Close()
+
+	source := server.AddFolder(retool.Folder{Name: "Drafts"})
+	target := server.AddFolder(retool.Folder{Name: "Published"})
+	app := server.AddApp(retool.App{Name: "Dashboard", FolderID: source.ID})
+	server.AddApp(retool.App{Name: "Admin", FolderID: source.ID})
+
+	client := newClient(t, server)
+	ctx := context.Background()
+
+	apps, err := client.ListApps(ctx, &retool.ListAppsOpts{FolderID: source.ID, Name: "Dashboard"})
+	assert.NoError(t, err)
+	assert.Equal(t, []retool.App{app}, apps)
+
+	moved, err := client.MoveAppToFolder(ctx, app.ID, target.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, target.ID, moved.FolderID)
+
+	_, err = client.MoveAppToFolder(ctx, app.ID, "folder_missing")
+	assert.ErrorIs(t, err, retool.ErrBadRequest)
+
+	assert.NoError(t, client.DeleteApp(ctx, app.ID))
+
+	_, err = client.GetApp(ctx, app.ID)
+	assert.ErrorIs(t, err, retool.ErrNotFound)
+}
+
+func TestServer_Environments(t *testing.T) {
+	server := retooltest.NewServer()
+	defer server.Close()
+
+	production := server.AddEnvironment(retool.Environment{Name: "production", Default: true})
+	client := newClient(t, server)
+	ctx := context.Background()
+
+	staging, err := client.CreateEnvironment(ctx, "staging", "Pre-production", "#E9AB11")
+	assert.NoError(t, err)
+
+	_, err = client.CreateEnvironment(ctx, "Staging", "", "")
+	assert.ErrorIs(t, err, retool.ErrConflict)
+
+	variable, err := client.CreateConfigurationVariable(ctx, "API_URL", "", false, []retool.Value{
+		{Environment: "production", Value: "https://api.example.com"},
+		{Environment: "staging", Value: "https://staging.example.com"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, production.ID, variable.Values[0].EnvironmentId)
+	assert.Equal(t, staging.ID, variable.Values[1].EnvironmentId)
+
+	assert.Error(t, client.DeleteEnvironment(ctx, production.ID))
+	assert.NoError(t, client.DeleteEnvironment(ctx, staging.ID))
+	assert.Len(t, server.Environments(), 1)
+}
+
+func TestServer_UserInvites(t *testing.T) {
+	server := retooltest.NewServer()
+	defer server.Close()
+
+	group := server.AddGroup(retool.Group{Name: "Support"})
+	server.AddUser(retool.User{Email: "jane@example.com", FirstName: "Jane", LastName: "Doe"})
+	client := newClient(t, server)
+	ctx := context.Background()
+
+	invite, err := client.CreateUserInvite(ctx, "john@example.com", &retool.CreateUserInviteOpts{GroupIDs: []int{group.ID}})
+	assert.NoError(t, err)
+	assert.Equal(t, retool.UserTypeDefault, invite.UserType)
+	assert.NotEmpty(t, invite.InviteLink)
+	id := strconv.Itoa(invite.ID)
+
+	_, err = client.CreateUserInvite(ctx, "jane@example.com", nil)
+	assert.ErrorIs(t, err, retool.ErrConflict)
+
+	_, err = client.CreateUserInvite(ctx, "jim@example.com", &retool.CreateUserInviteOpts{GroupIDs: []int{999}})
+	assert.ErrorIs(t, err, retool.ErrBadRequest)
+
+	fetched, err := client.GetGroup(ctx, strconv.Itoa(group.ID))
+	assert.NoError(t, err)
+	assert.Len(t, fetched.UserInvites, 1)
+
+	_, err = client.ResendUserInvite(ctx, id)
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.RevokeUserInvite(ctx, id))
+	assert.Empty(t, server.UserInvites())
+
+	fetched, err = client.GetGroup(ctx, strconv.Itoa(group.ID))
+	assert.NoError(t, err)
+	assert.Empty(t, fetched.UserInvites)
+}
@@ -0,0 +1,146 @@
+package retooltest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+func (s *Server) registerSpaces(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v2/spaces", s.handle(ScopeSpacesRead, s.listSpaces))
+	mux.HandleFunc("POST /api/v2/spaces", s.handle(ScopeSpacesWrite, s.createSpace))
+	mux.HandleFunc("GET /api/v2/spaces/{id}", s.handle(ScopeSpacesRead, s.getSpace))
+	mux.HandleFunc("PUT /api/v2/spaces/{id}", s.handle(ScopeSpacesWrite, s.updateSpace))
+	mux.HandleFunc("DELETE /api/v2/spaces/{id}", s.handle(ScopeSpacesWrite, s.deleteSpace))
+}
+
+// AddSpace stores a child space and returns it with its generated fields set.
+func (s *Server) AddSpace(space retool.Space) retool.Space {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return *s.addSpace(space)
+}
+
+// Spaces returns the stored child spaces.
+func (s *Server) Spaces() []retool.Space {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.spaces)
+}
+
+func (s *Server) addSpace(space retool.Space) *retool.Space {
+	if space.ID == "" {
+		space.ID = s.newID("space")
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	if space.CreatedAt == "" {
+		space.CreatedAt = now
+	}
+	space.UpdatedAt = now
+
+	s.spaces = append(s.spaces, &space)
+	return &space
+}
+
+func (s *Server) findSpace(id string) (*retool.Space, int) {
+	return findByID(s.spaces, id, func(sp *retool.Space) string { return sp.ID })
+}
+
+// spaceBody is the request body for creating and updating spaces.
+type spaceBody struct {
+	Name    string                     `json:"name"`
+	Domain  string                     `json:"domain"`
+	Options *retool.CreateSpaceOptions `json:"options"`
+}
+
+// validateSpace checks the space body and that the domain is not used by another space.
+func (s *Server) validateSpace(body spaceBody, id string) (int, error) {
+	if body.Name == "" || body.Domain == "" {
+		return http.StatusBadRequest, fmt.Errorf("name and domain are required")
+	}
+
+	for _, existing := range s.spaces {
+		if existing.Domain == body.Domain && existing.ID != id {
+			return http.StatusConflict, fmt.Errorf("Space with domain %s already exists", body.Domain)
+		}
+	}
+
+	return 0, nil
+}
+
+func (s *Server) listSpaces(w http.ResponseWriter, r *http.Request) {
+	paginate(s, w, r, values(s.spaces))
+}
+
+func (s *Server) getSpace(w http.ResponseWriter, r *http.Request) {
+	space, _ := s.findSpace(r.PathValue("id"))
+	if space == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Space %s not found", r.PathValue("id")))
+		return
+	}
+
+	writeData(w, http.StatusOK, space)
+}
+
+func (s *Server) createSpace(w http.ResponseWriter, r *http.Request) {
+	var body spaceBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if status, err := s.validateSpace(body, ""); err != nil {
+		writeError(w, status, err.Error())
+		return
+	}
+
+	if body.Options != nil {
+		for _, email := range body.Options.UsersToCopyAsAdmins {
+			if !slices.ContainsFunc(s.users, func(u *retool.User) bool { return u.Email == email }) {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("No user with email %s found for organization", email))
+				return
+			}
+		}
+	}
+
+	writeData(w, http.StatusOK, s.addSpace(retool.Space{Name: body.Name, Domain: body.Domain}))
+}
+
+func (s *Server) updateSpace(w http.ResponseWriter, r *http.Request) {
+	space, _ := s.findSpace(r.PathValue("id"))
+	if space == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Space %s not found", r.PathValue("id")))
+		return
+	}
+
+	var body spaceBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if status, err := s.validateSpace(body, space.ID); err != nil {
+		writeError(w, status, err.Error())
+		return
+	}
+
+	space.Name = body.Name
+	space.Domain = body.Domain
+	space.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	writeData(w, http.StatusOK, space)
+}
+
+func (s *Server) deleteSpace(w http.ResponseWriter, r *http.Request) {
+	space, i := s.findSpace(r.PathValue("id"))
+	if space == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Space %s not found", r.PathValue("id")))
+		return
+	}
+
+	s.spaces = slices.Delete(s.spaces, i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,144 @@
+package retooltest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+// inviteValidity is how long a user invite can be claimed after it is sent.
+const inviteValidity = 7 * 24 * time.Hour
+
+func (s *Server) registerUserInvites(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v2/user_invites", s.handle(ScopeUsersRead, s.listUserInvites))
+	mux.HandleFunc("POST /api/v2/user_invites", s.handle(ScopeUsersWrite, s.createUserInvite))
+	mux.HandleFunc("GET /api/v2/user_invites/{id}", s.handle(ScopeUsersRead, s.getUserInvite))
+	mux.HandleFunc("DELETE /api/v2/user_invites/{id}", s.handle(ScopeUsersWrite, s.revokeUserInvite))
+	mux.HandleFunc("POST /api/v2/user_invites/{id}/resend", s.handle(ScopeUsersWrite, s.resendUserInvite))
+}
+
+// UserInvites returns the stored user invites.
+func (s *Server) UserInvites() []retool.UserInvite {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.userInvites)
+}
+
+func (s *Server) findUserInvite(id string) (*retool.UserInvite, int) {
+	return findByID(s.userInvites, id, func(i *retool.UserInvite) string { return strconv.Itoa(i.ID) })
+}
+
+func (s *Server) listUserInvites(w http.ResponseWriter, r *http.Request) {
+	paginate(s, w, r, values(s.userInvites))
+}
+
+func (s *Server) getUserInvite(w http.ResponseWriter, r *http.Request) {
+	invite, _ := s.findUserInvite(r.PathValue("id"))
+	if invite == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("User invite %s not found", r.PathValue("id")))
+		return
+	}
+
+	writeData(w, http.StatusOK, invite)
+}
+
+// createUserInvite stores the invite and adds it to the user invites of the groups it names.
+func (s *Server) createUserInvite(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Email    string                 `json:"email"`
+		UserType string                 `json:"user_type"`
+		GroupIDs []int                  `json:"group_ids"`
+		Metadata map[string]interface{} `json:"metadata"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if body.Email == "" {
+		writeError(w, http.StatusBadRequest, "email is required")
+		return
+	}
+
+	if err := (&retool.User{UserType: body.UserType}).Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	groups := make([]*retool.Group, 0, len(body.GroupIDs))
+	for _, id := range body.GroupIDs {
+		group, _ := s.findGroup(strconv.Itoa(id))
+		if group == nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Group %d not found", id))
+			return
+		}
+		groups = append(groups, group)
+	}
+
+	for _, user := range s.users {
+		if user.Email == body.Email {
+			writeError(w, http.StatusConflict, fmt.Sprintf("User with email %s already exists", body.Email))
+			return
+		}
+	}
+
+	now := time.Now().UTC()
+	id := s.newIntID()
+	invite := &retool.UserInvite{
+		ID:           id,
+		LegacyID:     id,
+		InvitedBy:    "retooltest",
+		InvitedEmail: body.Email,
+		ExpiresAt:    now.Add(inviteValidity).Format(time.RFC3339),
+		UserType:     body.UserType,
+		Metadata:     body.Metadata,
+		CreatedAt:    now.Format(time.RFC3339),
+		InviteLink:   fmt.Sprintf("%s/invite/%d", s.URL, id),
+	}
+	if invite.UserType == "" {
+		invite.UserType = retool.UserTypeDefault
+	}
+
+	s.userInvites = append(s.userInvites, invite)
+	for _, group := range groups {
+		group.UserInvites = append(group.UserInvites, *invite)
+	}
+
+	writeData(w, http.StatusOK, invite)
+}
+
+// revokeUserInvite deletes the invite and removes it from the groups it was added to.
+func (s *Server) revokeUserInvite(w http.ResponseWriter, r *http.Request) {
+	invite, i := s.findUserInvite(r.PathValue("id"))
+	if invite == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("User invite %s not found", r.PathValue("id")))
+		return
+	}
+
+	for _, group := range s.groups {
+		group.UserInvites = slices.DeleteFunc(group.UserInvites, func(u retool.UserInvite) bool { return u.ID == invite.ID })
+	}
+
+	s.userInvites = slices.Delete(s.userInvites, i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) resendUserInvite(w http.ResponseWriter, r *http.Request) {
+	invite, _ := s.findUserInvite(r.PathValue("id"))
+	if invite == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("User invite %s not found", r.PathValue("id")))
+		return
+	}
+
+	if invite.ClaimedAt != "" {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("User invite %s has already been claimed", r.PathValue("id")))
+		return
+	}
+
+	invite.ExpiresAt = time.Now().UTC().Add(inviteValidity).Format(time.RFC3339)
+	writeData(w, http.StatusOK, invite)
+}
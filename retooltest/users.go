@@ -0,0 +1,231 @@
+package retooltest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+)
+
+func (s *Server) registerUsers(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v2/users", s.handle(ScopeUsersRead, s.listUsers))
+	mux.HandleFunc("POST /api/v2/users", s.handle(ScopeUsersWrite, s.createUser))
+	mux.HandleFunc("GET /api/v2/users/{id}", s.handle(ScopeUsersRead, s.getUser))
+	mux.HandleFunc("PATCH /api/v2/users/{id}", s.handle(ScopeUsersWrite, s.updateUser))
+	mux.HandleFunc("DELETE /api/v2/users/{id}", s.handle(ScopeUsersWrite, s.deleteUser))
+	mux.HandleFunc("POST /api/v2/users/{id}/user_attributes", s.handle(ScopeUsersWrite, s.updateUserAttributes))
+	mux.HandleFunc("DELETE /api/v2/users/{id}/user_attributes/{name}", s.handle(ScopeUsersWrite, s.deleteUserAttribute))
+	mux.HandleFunc("GET /api/v2/user_attributes", s.handle(ScopeUsersRead, s.listOrganizationAttributes))
+}
+
+// AddUser stores a user and returns it with its generated fields set.
+func (s *Server) AddUser(user retool.User) retool.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return *s.addUser(user)
+}
+
+// Users returns the stored users.
+func (s *Server) Users() []retool.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.users)
+}
+
+// AddOrganizationAttribute stores a user attribute configured for the organization.
+func (s *Server) AddOrganizationAttribute(attribute retool.OrganizationAttribute) retool.OrganizationAttribute {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if attribute.ID == "" {
+		attribute.ID = s.newID("attribute")
+	}
+	if attribute.DataType == "" {
+		attribute.DataType = "string"
+	}
+
+	s.organizationAttributes = append(s.organizationAttributes, &attribute)
+	return attribute
+}
+
+func (s *Server) addUser(user retool.User) *retool.User {
+	if user.ID == "" {
+		user.ID = s.newID("user")
+	}
+	if user.LegacyID == 0 {
+		user.LegacyID = s.newIntID()
+	}
+	if user.UserType == "" {
+		user.UserType = retool.UserTypeDefault
+	}
+	if user.CreatedAt == "" {
+		user.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	s.users = append(s.users, &user)
+	return &user
+}
+
+func (s *Server) findUser(id string) (*retool.User, int) {
+	return findByID(s.users, id, func(u *retool.User) string { return u.ID })
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var users []retool.User
+	for _, user := range s.users {
+		if email := query.Get("email"); email != "" && user.Email != email {
+			continue
+		}
+		if firstName := query.Get("first_name"); firstName != "" && user.FirstName != firstName {
+			continue
+		}
+		if lastName := query.Get("last_name"); lastName != "" && user.LastName != lastName {
+			continue
+		}
+		users = append(users, *user)
+	}
+
+	paginate(s, w, r, users)
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	user, _ := s.findUser(r.PathValue("id"))
+	if user == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("No user '%s' found for organization", r.PathValue("id")))
+		return
+	}
+
+	writeData(w, http.StatusOK, user)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	var user retool.User
+	if !decodeBody(w, r, &user) {
+		return
+	}
+
+	if user.Email == "" || user.FirstName == "" || user.LastName == "" {
+		writeError(w, http.StatusBadRequest, "email, first_name and last_name are required")
+		return
+	}
+
+	if err := user.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	for _, existing := range s.users {
+		if existing.Email == user.Email {
+			writeError(w, http.StatusConflict, fmt.Sprintf("User with email %s already exists", user.Email))
+			return
+		}
+	}
+
+	user.ID = ""
+	writeData(w, http.StatusOK, s.addUser(user))
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	user, _ := s.findUser(r.PathValue("id"))
+	if user == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("No user '%s' found for organization", r.PathValue("id")))
+		return
+	}
+
+	operations, ok := decodeOperations(w, r)
+	if !ok {
+		return
+	}
+
+	updated := *user
+	if err := applyOperations(&updated, operations); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Patched document failed schema validation: %s", err))
+		return
+	}
+
+	if updated.Email == "" || updated.FirstName == "" || updated.LastName == "" {
+		writeError(w, http.StatusBadRequest, "Patched document failed schema validation: email, first_name and last_name are required")
+		return
+	}
+
+	if err := updated.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Patched document failed schema validation: %s", err))
+		return
+	}
+
+	updated.ID = user.ID
+	*user = updated
+	writeData(w, http.StatusOK, user)
+}
+
+// deleteUser disables the user, as the Retool API does.
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	user, _ := s.findUser(r.PathValue("id"))
+	if user == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("No user '%s' found for organization", r.PathValue("id")))
+		return
+	}
+
+	user.Active = false
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) updateUserAttributes(w http.ResponseWriter, r *http.Request) {
+	user, _ := s.findUser(r.PathValue("id"))
+	if user == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("No user '%s' found for organization", r.PathValue("id")))
+		return
+	}
+
+	var attributes []retool.UserAttribute
+	if !decodeBody(w, r, &attributes) {
+		return
+	}
+
+	if len(attributes) == 0 {
+		writeError(w, http.StatusBadRequest, "No attributes provided")
+		return
+	}
+
+	metadata, _ := user.Metadata.(map[string]interface{})
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+
+	for _, attribute := range attributes {
+		if attribute.Name == "" {
+			writeError(w, http.StatusBadRequest, "Attribute name is required")
+			return
+		}
+		metadata[attribute.Name] = attribute.Value
+	}
+
+	user.Metadata = metadata
+	writeData(w, http.StatusOK, user)
+}
+
+func (s *Server) deleteUserAttribute(w http.ResponseWriter, r *http.Request) {
+	user, _ := s.findUser(r.PathValue("id"))
+	if user == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("No user '%s' found for organization", r.PathValue("id")))
+		return
+	}
+
+	metadata, _ := user.Metadata.(map[string]interface{})
+	if _, ok := metadata[r.PathValue("name")]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Attribute '%s' not found", r.PathValue("name")))
+		return
+	}
+
+	delete(metadata, r.PathValue("name"))
+	writeData(w, http.StatusOK, user)
+}
+
+func (s *Server) listOrganizationAttributes(w http.ResponseWriter, r *http.Request) {
+	paginate(s, w, r, values(s.organizationAttributes))
+}
@@ -0,0 +1,172 @@
+package retoolsdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how Client.Do retries requests that fail with a transport error,
+// a 429 (Too Many Requests) or a 5xx response.
+// MaxAttempts is the total number of attempts including the first one (default: 4).
+// MinBackoff is the base delay before the first retry, doubled on every following retry (default: 500ms).
+// MaxBackoff caps the delay before a retry, both the exponential delay and the one asked for by a Retry-After
+// header (default: 30s).
+// RetryNonIdempotent also retries POST and PATCH requests, which are not retried by default.
+// OnRetry is called before waiting for every retry and can be used for logging.
+type RetryPolicy struct {
+	MaxAttempts        int
+	MinBackoff         time.Duration
+	MaxBackoff         time.Duration
+	RetryNonIdempotent bool
+	OnRetry            func(RetryAttempt)
+}
+
+// RetryAttempt describes a failed attempt that is about to be retried.
+// Attempt is the number of the attempt that failed, starting at 1.
+// StatusCode is 0 and Err is set when the attempt failed with a transport error.
+// Wait is the delay before the next attempt is made.
+type RetryAttempt struct {
+	Method     string
+	URL        string
+	Attempt    int
+	StatusCode int
+	Err        error
+	Wait       time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used for zero values passed to WithRetryPolicy.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+// WithRetryPolicy enables retries with jittered exponential backoff for the client.
+// Zero values in the policy are replaced with the values from DefaultRetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		defaults := DefaultRetryPolicy()
+
+		if policy.MaxAttempts < 0 || policy.MinBackoff < 0 || policy.MaxBackoff < 0 {
+			return errors.New("retry policy values must not be negative")
+		}
+
+		if policy.MaxAttempts == 0 {
+			policy.MaxAttempts = defaults.MaxAttempts
+		}
+		if policy.MinBackoff == 0 {
+			policy.MinBackoff = defaults.MinBackoff
+		}
+		if policy.MaxBackoff == 0 {
+			policy.MaxBackoff = defaults.MaxBackoff
+		}
+
+		if policy.MinBackoff > policy.MaxBackoff {
+			return errors.New("retry policy MinBackoff must not be greater than MaxBackoff")
+		}
+
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// attempts returns the number of attempts allowed for the given HTTP method.
+func (p *RetryPolicy) attempts(method string) int {
+	if p == nil {
+		return 1
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return p.MaxAttempts
+	default:
+		if p.RetryNonIdempotent {
+			return p.MaxAttempts
+		}
+		return 1
+	}
+}
+
+// backoff returns the delay before the next attempt. A Retry-After header on the response takes precedence
+// over the exponential backoff, but is capped at MaxBackoff so that a server cannot stall a call indefinitely.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, p.MaxBackoff)
+		}
+	}
+
+	delay := p.MaxBackoff
+	if shift := attempt - 1; shift < 32 {
+		if d := p.MinBackoff << shift; d > 0 && d < p.MaxBackoff {
+			delay = d
+		}
+	}
+
+	// Equal jitter: keep half of the delay and randomize the other half.
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// shouldRetry reports whether a request that returned resp or err can be retried.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// discardResponse drains and closes the response body so the underlying connection can be reused.
+func discardResponse(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	_ = resp.Body.Close()
+}
+
+// sleepContext waits for the given duration or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,208 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_RetriesServerErrors(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "user_123"}}`)
+	}))
+	defer server.Close()
+
+	var retries []retool.RetryAttempt
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithRetryPolicy(retool.RetryPolicy{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+		OnRetry: func(attempt retool.RetryAttempt) {
+			retries = append(retries, attempt)
+		},
+	}))
+	assert.NoError(t, err)
+
+	user, err := client.GetUser(context.Background(), "user_123")
+	assert.NoError(t, err)
+	assert.Equal(t, "user_123", user.ID)
+	assert.Equal(t, int32(3), requests.Load())
+	assert.Len(t, retries, 2)
+	assert.Equal(t, 1, retries[0].Attempt)
+	assert.Equal(t, http.StatusServiceUnavailable, retries[0].StatusCode)
+	assert.Equal(t, "GET", retries[0].Method)
+}
+
+func TestRetryPolicy_StopsAfterMaxAttempts(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprintln(w, `{"success": false, "message": "bad gateway"}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithRetryPolicy(retool.RetryPolicy{
+		MaxAttempts: 2,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	}))
+	assert.NoError(t, err)
+
+	_, err = client.GetUser(context.Background(), "user_123")
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestRetryPolicy_HonorsRetryAfter(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "user_123"}}`)
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithRetryPolicy(retool.RetryPolicy{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 2 * time.Second,
+		OnRetry: func(attempt retool.RetryAttempt) {
+			waits = append(waits, attempt.Wait)
+		},
+	}))
+	assert.NoError(t, err)
+
+	_, err = client.GetUser(context.Background(), "user_123")
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{time.Second}, waits)
+}
+
+func TestRetryPolicy_CapsRetryAfter(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "86400")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "user_123"}}`)
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithRetryPolicy(retool.RetryPolicy{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+		OnRetry: func(attempt retool.RetryAttempt) {
+			waits = append(waits, attempt.Wait)
+		},
+	}))
+	assert.NoError(t, err)
+
+	_, err = client.GetUser(context.Background(), "user_123")
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{5 * time.Millisecond}, waits)
+}
+
+func TestRetryPolicy_DoesNotRetryPostByDefault(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintln(w, `{"success": false, "message": "Internal server error"}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithRetryPolicy(retool.RetryPolicy{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: time.Millisecond,
+	}))
+	assert.NoError(t, err)
+
+	_, err = client.CreateUser(context.Background(), "jane.doe@example.com", "Jane", "Doe", nil)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestRetryPolicy_RetriesPostWhenEnabled(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "user_123", "email": "jane.doe@example.com"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithRetryPolicy(retool.RetryPolicy{
+		MinBackoff:         time.Millisecond,
+		MaxBackoff:         time.Millisecond,
+		RetryNonIdempotent: true,
+	}))
+	assert.NoError(t, err)
+
+	user, err := client.CreateUser(context.Background(), "jane.doe@example.com", "Jane", "Doe", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "user_123", user.ID)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestRetryPolicy_ContextCanceledDuringBackoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL, retool.WithRetryPolicy(retool.RetryPolicy{
+		MinBackoff: time.Minute,
+		MaxBackoff: time.Minute,
+	}))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = client.GetUser(ctx, "user_123")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithRetryPolicy_Invalid(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "example.com", retool.WithRetryPolicy(retool.RetryPolicy{
+		MinBackoff: time.Minute,
+		MaxBackoff: time.Second,
+	}))
+	assert.Nil(t, client)
+	assert.EqualError(t, err, "applying client option: retry policy MinBackoff must not be greater than MaxBackoff")
+
+	client, err = retool.NewClient("test-api-key", "example.com", retool.WithRetryPolicy(retool.RetryPolicy{
+		MaxAttempts: -1,
+	}))
+	assert.Nil(t, client)
+	assert.EqualError(t, err, "applying client option: retry policy values must not be negative")
+}
@@ -0,0 +1,48 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// quote formats the value as a SCIM string literal, which uses the JSON string syntax.
+func quote(value string) string {
+	var buf strings.Builder
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value)
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// Eq returns the filter expression matching the resources whose attribute equals value, such as
+// `userName eq "jane@example.com"`.
+func Eq(attribute, value string) string {
+	return fmt.Sprintf("%s eq %s", attribute, quote(value))
+}
+
+// And returns the filter expression matching the resources that match all of the filters.
+func And(filters ...string) string {
+	return join("and", filters)
+}
+
+// Or returns the filter expression matching the resources that match any of the filters.
+func Or(filters ...string) string {
+	return join("or", filters)
+}
+
+// join combines the filters with the logical operator, grouping each of them in parentheses so that nested
+// combinations keep their meaning.
+func join(operator string, filters []string) string {
+	if len(filters) == 1 {
+		return filters[0]
+	}
+
+	grouped := make([]string, len(filters))
+	for i, filter := range filters {
+		grouped[i] = "(" + filter + ")"
+	}
+
+	return strings.Join(grouped, " "+operator+" ")
+}
@@ -0,0 +1,21 @@
+package scim_test
+
+import (
+	"testing"
+
+	"github.com/thoughtgears/retoolsdk/scim"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEq_QuotesValue(t *testing.T) {
+	assert.Equal(t, `userName eq "jane@example.com"`, scim.Eq("userName", "jane@example.com"))
+	assert.Equal(t, `displayName eq "R&D \"core\""`, scim.Eq("displayName", `R&D "core"`))
+}
+
+func TestAndOr(t *testing.T) {
+	assert.Equal(t, `userName eq "jane"`, scim.And(scim.Eq("userName", "jane")))
+	assert.Equal(t,
+		`(active eq "true") and ((userName eq "jane") or (userName eq "john"))`,
+		scim.And(scim.Eq("active", "true"), scim.Or(scim.Eq("userName", "jane"), scim.Eq("userName", "john"))))
+}
@@ -0,0 +1,80 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// Member is a member of a group. Value is the ID of the user.
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// Group is a SCIM group.
+type Group struct {
+	Schemas     []string `json:"schemas,omitempty"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// ListGroups returns the groups matching the filter of opts.
+func (c *Client) ListGroups(ctx context.Context, opts *ListOpts) ([]Group, error) {
+	return collect(c.AllGroups(ctx, opts))
+}
+
+// AllGroups returns an iterator over the groups matching the filter of opts, fetching pages lazily as the iteration
+// progresses.
+func (c *Client) AllGroups(ctx context.Context, opts *ListOpts) iter.Seq2[Group, error] {
+	return paginate[Group](ctx, c, fmt.Sprintf("%s/Groups", c.BaseURL), opts)
+}
+
+// GetGroup returns the group with the given ID.
+func (c *Client) GetGroup(ctx context.Context, id string) (*Group, error) {
+	baseURL := fmt.Sprintf("%s/Groups/%s", c.BaseURL, id)
+	return doRequest[Group](ctx, c, "GET", baseURL, nil)
+}
+
+// CreateGroup creates the group and returns it. The group schema is added when Schemas is empty.
+func (c *Client) CreateGroup(ctx context.Context, group *Group) (*Group, error) {
+	if group == nil {
+		return nil, errors.New("group cannot be nil")
+	}
+
+	if group.DisplayName == "" {
+		return nil, errors.New("group display name is required")
+	}
+
+	requestBody := *group
+	if len(requestBody.Schemas) == 0 {
+		requestBody.Schemas = []string{GroupSchema}
+	}
+
+	baseURL := fmt.Sprintf("%s/Groups", c.BaseURL)
+	return doRequest[Group](ctx, c, "POST", baseURL, requestBody)
+}
+
+// PatchGroup applies the operations to the group and returns the updated group, or nil when the server responds
+// without content. AddMembers and RemoveMember build the operations changing the members.
+func (c *Client) PatchGroup(ctx context.Context, id string, operations []PatchOperation) (*Group, error) {
+	requestBody, err := patchRequest(operations)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/Groups/%s", c.BaseURL, id)
+	return doRequest[Group](ctx, c, "PATCH", baseURL, requestBody)
+}
+
+// DeleteGroup deletes the group with the given ID.
+func (c *Client) DeleteGroup(ctx context.Context, id string) error {
+	baseURL := fmt.Sprintf("%s/Groups/%s", c.BaseURL, id)
+	_, err := doRequest[any](ctx, c, "DELETE", baseURL, nil)
+	return err
+}
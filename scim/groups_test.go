@@ -0,0 +1,113 @@
+package scim_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+	"github.com/thoughtgears/retoolsdk/scim"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllGroups_StopsAtTotalResults(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/api/scim/v2/Groups", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("startIndex"))
+		assert.Equal(t, "100", r.URL.Query().Get("count"))
+		fmt.Fprintln(w, `{"totalResults": 2, "startIndex": 1, "itemsPerPage": 2, "Resources": [{"id": "group_1", "displayName": "Engineering"}, {"id": "group_2", "displayName": "Support"}]}`)
+	}))
+	defer server.Close()
+
+	var names []string
+	for group, err := range newSCIMClient(t, server).AllGroups(context.Background(), nil) {
+		assert.NoError(t, err)
+		names = append(names, group.DisplayName)
+	}
+	assert.Equal(t, []string{"Engineering", "Support"}, names)
+	assert.Equal(t, 1, requests)
+}
+
+func TestCreateGroup_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "displayName": "Engineering", "members": [{"value": "user_1"}]}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"id": "group_1", "displayName": "Engineering", "members": [{"value": "user_1", "display": "jane@example.com"}]}`)
+	}))
+	defer server.Close()
+
+	group, err := newSCIMClient(t, server).CreateGroup(context.Background(), &scim.Group{
+		DisplayName: "Engineering",
+		Members:     []scim.Member{{Value: "user_1"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "group_1", group.ID)
+	assert.Equal(t, "jane@example.com", group.Members[0].Display)
+}
+
+func TestPatchGroup_Members(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/scim/v2/Groups/group_1", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [
+			{"op": "add", "path": "members", "value": [{"value": "user_2"}, {"value": "user_3"}]},
+			{"op": "remove", "path": "members[value eq \"user_1\"]"}
+		]}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	group, err := newSCIMClient(t, server).PatchGroup(context.Background(), "group_1", []scim.PatchOperation{
+		scim.AddMembers("user_2", "user_3"),
+		scim.RemoveMember("user_1"),
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, group)
+}
+
+func TestGetGroup_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:Error"], "status": "404", "detail": "Group not found"}`)
+	}))
+	defer server.Close()
+
+	_, err := newSCIMClient(t, server).GetGroup(context.Background(), "group_9")
+	assert.EqualError(t, err, "Group not found")
+	assert.True(t, errors.Is(err, retool.ErrNotFound))
+}
+
+func TestPatchOperation_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		op   scim.PatchOperation
+		err  string
+	}{
+		{"replace", scim.PatchOperation{Op: "Replace", Path: "displayName", Value: "Support"}, ""},
+		{"add without path", scim.PatchOperation{Op: scim.OpAdd, Value: map[string]interface{}{"displayName": "Support"}}, ""},
+		{"add without value", scim.PatchOperation{Op: scim.OpAdd, Path: "members"}, "value is required for add"},
+		{"remove without path", scim.PatchOperation{Op: scim.OpRemove}, "path is required for remove"},
+		{"invalid op", scim.PatchOperation{Op: "copy"}, "invalid patch operation: copy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.op.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
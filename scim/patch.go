@@ -0,0 +1,83 @@
+package scim
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PATCH operation types.
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+)
+
+// PatchOperation is a single operation of a SCIM PATCH request. Path selects the attribute to change, such as
+// "active" or `members[value eq "user_1"]`; an add or replace without a path sets the attributes of Value.
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Validate ensures that the operation type is valid and that the path or value it needs is set. Operation types
+// are matched case-insensitively, as identity providers such as Azure AD send "Replace".
+func (o PatchOperation) Validate() error {
+	switch strings.ToLower(o.Op) {
+	case OpAdd, OpReplace:
+		if o.Value == nil {
+			return fmt.Errorf("value is required for %s", o.Op)
+		}
+	case OpRemove:
+		if o.Path == "" {
+			return errors.New("path is required for remove")
+		}
+	default:
+		return fmt.Errorf("invalid patch operation: %s", o.Op)
+	}
+
+	return nil
+}
+
+// patchRequest validates the operations and returns the body of a PATCH request applying them.
+func patchRequest(operations []PatchOperation) (interface{}, error) {
+	if len(operations) == 0 {
+		return nil, errors.New("no operations provided")
+	}
+
+	for _, op := range operations {
+		if err := op.Validate(); err != nil {
+			return nil, fmt.Errorf("validation failed for operation: %w", err)
+		}
+	}
+
+	return struct {
+		Schemas    []string         `json:"schemas"`
+		Operations []PatchOperation `json:"Operations"`
+	}{
+		Schemas:    []string{PatchOpSchema},
+		Operations: operations,
+	}, nil
+}
+
+// SetActive returns the operation activating or deactivating a user, as identity providers do when a user is
+// assigned or unassigned.
+func SetActive(active bool) PatchOperation {
+	return PatchOperation{Op: OpReplace, Path: "active", Value: active}
+}
+
+// AddMembers returns the operation adding the users to a group.
+func AddMembers(userIDs ...string) PatchOperation {
+	members := make([]Member, len(userIDs))
+	for i, id := range userIDs {
+		members[i] = Member{Value: id}
+	}
+
+	return PatchOperation{Op: OpAdd, Path: "members", Value: members}
+}
+
+// RemoveMember returns the operation removing the user from a group.
+func RemoveMember(userID string) PatchOperation {
+	return PatchOperation{Op: OpRemove, Path: fmt.Sprintf("members[%s]", Eq("value", userID))}
+}
@@ -0,0 +1,246 @@
+// Package scim provides a SCIM 2.0 client for provisioning Retool users and groups, the protocol identity providers
+// such as Okta and Azure AD use.
+//
+// The client calls the SCIM endpoint of a Retool instance through a retoolsdk.Client, so requests share its
+// authentication, timeout, retry policy, rate limits, middlewares and logging. The retoolsdk.Client must be created
+// with a token that is authorized for SCIM:
+//
+//	client, err := retoolsdk.NewClient(scimToken, "retool.example.com", retoolsdk.WithRetryPolicy(policy))
+//	if err != nil {
+//		return err
+//	}
+//	provisioner, err := scim.NewClient(client)
+//	if err != nil {
+//		return err
+//	}
+//	users, err := provisioner.ListUsers(ctx, &scim.ListOpts{Filter: scim.Eq("userName", "jane@example.com")})
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	retool "github.com/thoughtgears/retoolsdk"
+	"github.com/thoughtgears/retoolsdk/internal/apierror"
+)
+
+// SCIM schema URIs.
+const (
+	UserSchema         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema        = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// contentType is the media type of SCIM request bodies.
+const contentType = "application/scim+json"
+
+// defaultCount is the number of resources requested per page when ListOpts.Count is not set.
+const defaultCount = 100
+
+// Client is a SCIM 2.0 client for the users and groups of a Retool instance.
+// BaseURL is the URL of the SCIM endpoint, derived from the Endpoint of the retoolsdk.Client.
+type Client struct {
+	BaseURL string
+
+	client *retool.Client
+}
+
+// NewClient creates a SCIM client calling the Retool instance of client through it.
+func NewClient(client *retool.Client) (*Client, error) {
+	if client == nil {
+		return nil, errors.New("retool client is required")
+	}
+
+	return &Client{
+		BaseURL: client.Endpoint + "/api/scim/v2",
+		client:  client,
+	}, nil
+}
+
+// Meta is the resource metadata maintained by the server.
+type Meta struct {
+	ResourceType string `json:"resourceType,omitempty"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+// Error is returned when the SCIM endpoint responds with an error status code. It wraps the *retoolsdk.APIError of
+// the response, so errors.Is matches the sentinel errors of retoolsdk, such as retoolsdk.ErrConflict for a user
+// name that is already taken.
+type Error struct {
+	// ScimType is the SCIM error type, such as "uniqueness" or "invalidFilter", if the server reported one.
+	ScimType string
+	Err      *retool.APIError
+}
+
+// Error returns the detail reported by the server, prefixed with the SCIM error type when there is one.
+func (e *Error) Error() string {
+	if e.ScimType != "" {
+		return fmt.Sprintf("%s: %s", e.ScimType, e.Err.Error())
+	}
+
+	return e.Err.Error()
+}
+
+// Unwrap returns the *retoolsdk.APIError of the response.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// newError builds an Error from the response and its already read body.
+func newError(resp *http.Response, body []byte) *Error {
+	var scimErr struct {
+		ScimType string `json:"scimType"`
+		Detail   string `json:"detail"`
+	}
+	_ = json.Unmarshal(body, &scimErr)
+
+	details := apierror.Describe(resp, body)
+
+	return &Error{
+		ScimType: scimErr.ScimType,
+		Err: &retool.APIError{
+			StatusCode: resp.StatusCode,
+			Message:    scimErr.Detail,
+			Body:       details.Body,
+			Method:     details.Method,
+			URL:        details.URL,
+			RequestID:  details.RequestID,
+		},
+	}
+}
+
+// doRequest is a helper function for making SCIM requests. The body is encoded as application/scim+json, and a
+// response without content returns nil.
+func doRequest[T any](ctx context.Context, c *Client, method, url string, body interface{}) (*T, error) {
+	var requestBody interface{}
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling request: %w", err)
+		}
+		requestBody = retool.RawBody{ContentType: contentType, Data: data}
+	}
+
+	resp, err := c.client.Do(ctx, method, url, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, newError(resp, responseBody)
+	}
+
+	if resp.StatusCode == http.StatusNoContent || len(responseBody) == 0 {
+		return nil, nil
+	}
+
+	var result T
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ListOpts is a struct that contains optional query parameters for listing users and groups.
+type ListOpts struct {
+	// Filter is a SCIM filter expression, such as `userName eq "jane@example.com"`. Eq, And and Or build filters
+	// with correctly quoted values.
+	Filter string
+	// Count is the number of resources requested per page, 100 when it is zero.
+	Count int
+}
+
+// values returns the query parameters for the options and the page starting at startIndex.
+func (o *ListOpts) values(startIndex int) url.Values {
+	query := make(url.Values)
+	query.Set("startIndex", strconv.Itoa(startIndex))
+
+	count := defaultCount
+	if o != nil && o.Count > 0 {
+		count = o.Count
+	}
+	query.Set("count", strconv.Itoa(count))
+
+	if o != nil && o.Filter != "" {
+		query.Set("filter", o.Filter)
+	}
+
+	return query
+}
+
+// listResponse is a page of a SCIM listing.
+type listResponse[T any] struct {
+	TotalResults int `json:"totalResults"`
+	StartIndex   int `json:"startIndex"`
+	ItemsPerPage int `json:"itemsPerPage"`
+	Resources    []T `json:"Resources"`
+}
+
+// paginate returns an iterator over the resources of a listing, fetching the pages by startIndex lazily until
+// totalResults resources have been returned.
+func paginate[T any](ctx context.Context, c *Client, baseURL string, opts *ListOpts) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		startIndex := 1
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := doRequest[listResponse[T]](ctx, c, "GET", fmt.Sprintf("%s?%s", baseURL, opts.values(startIndex).Encode()), nil)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if page == nil {
+				return
+			}
+
+			for _, resource := range page.Resources {
+				if !yield(resource, nil) {
+					return
+				}
+			}
+
+			startIndex += len(page.Resources)
+			if len(page.Resources) == 0 || startIndex > page.TotalResults {
+				return
+			}
+		}
+	}
+}
+
+// collect gathers the resources of an iterator into a slice, stopping at the first error.
+func collect[T any](resources iter.Seq2[T, error]) ([]T, error) {
+	var all []T
+
+	for resource, err := range resources {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resource)
+	}
+
+	return all, nil
+}
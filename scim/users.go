@@ -0,0 +1,112 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Name is the name of a user.
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// Email is an email address of a user.
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// GroupRef is a group a user belongs to. It is maintained by the server.
+type GroupRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// User is a SCIM user.
+type User struct {
+	Schemas     []string   `json:"schemas,omitempty"`
+	ID          string     `json:"id,omitempty"`
+	ExternalID  string     `json:"externalId,omitempty"`
+	UserName    string     `json:"userName"`
+	Name        *Name      `json:"name,omitempty"`
+	DisplayName string     `json:"displayName,omitempty"`
+	Emails      []Email    `json:"emails,omitempty"`
+	Active      bool       `json:"active"`
+	Groups      []GroupRef `json:"groups,omitempty"`
+	Meta        *Meta      `json:"meta,omitempty"`
+}
+
+// PrimaryEmail returns the primary email address of the user, or the first one when none is marked primary.
+func (u *User) PrimaryEmail() string {
+	if i := slices.IndexFunc(u.Emails, func(email Email) bool { return email.Primary }); i >= 0 {
+		return u.Emails[i].Value
+	}
+
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+
+	return ""
+}
+
+// ListUsers returns the users matching the filter of opts.
+func (c *Client) ListUsers(ctx context.Context, opts *ListOpts) ([]User, error) {
+	return collect(c.AllUsers(ctx, opts))
+}
+
+// AllUsers returns an iterator over the users matching the filter of opts, fetching pages lazily as the iteration
+// progresses.
+func (c *Client) AllUsers(ctx context.Context, opts *ListOpts) iter.Seq2[User, error] {
+	return paginate[User](ctx, c, fmt.Sprintf("%s/Users", c.BaseURL), opts)
+}
+
+// GetUser returns the user with the given ID.
+func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
+	baseURL := fmt.Sprintf("%s/Users/%s", c.BaseURL, id)
+	return doRequest[User](ctx, c, "GET", baseURL, nil)
+}
+
+// CreateUser creates the user and returns it. The user schema is added when Schemas is empty.
+func (c *Client) CreateUser(ctx context.Context, user *User) (*User, error) {
+	if user == nil {
+		return nil, errors.New("user cannot be nil")
+	}
+
+	if user.UserName == "" {
+		return nil, errors.New("user name is required")
+	}
+
+	requestBody := *user
+	if len(requestBody.Schemas) == 0 {
+		requestBody.Schemas = []string{UserSchema}
+	}
+
+	baseURL := fmt.Sprintf("%s/Users", c.BaseURL)
+	return doRequest[User](ctx, c, "POST", baseURL, requestBody)
+}
+
+// PatchUser applies the operations to the user and returns the updated user, or nil when the server responds
+// without content.
+func (c *Client) PatchUser(ctx context.Context, id string, operations []PatchOperation) (*User, error) {
+	requestBody, err := patchRequest(operations)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/Users/%s", c.BaseURL, id)
+	return doRequest[User](ctx, c, "PATCH", baseURL, requestBody)
+}
+
+// DeleteUser deletes the user with the given ID.
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	baseURL := fmt.Sprintf("%s/Users/%s", c.BaseURL, id)
+	_, err := doRequest[any](ctx, c, "DELETE", baseURL, nil)
+	return err
+}
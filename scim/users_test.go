@@ -0,0 +1,154 @@
+package scim_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+	"github.com/thoughtgears/retoolsdk/scim"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newSCIMClient returns a SCIM client calling the server.
+func newSCIMClient(t *testing.T, server *httptest.Server) *scim.Client {
+	client, err := retool.NewClient("scim-token", server.URL)
+	assert.NoError(t, err)
+
+	scimClient, err := scim.NewClient(client)
+	assert.NoError(t, err)
+
+	return scimClient
+}
+
+func TestNewClient_Nil(t *testing.T) {
+	_, err := scim.NewClient(nil)
+	assert.EqualError(t, err, "retool client is required")
+}
+
+func TestListUsers_FilterAndPages(t *testing.T) {
+	var startIndexes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/scim/v2/Users", r.URL.Path)
+		assert.Equal(t, "Bearer scim-token", r.Header.Get("Authorization"))
+		assert.Equal(t, `emails.value eq "jane@example.com"`, r.URL.Query().Get("filter"))
+		assert.Equal(t, "1", r.URL.Query().Get("count"))
+
+		startIndex := r.URL.Query().Get("startIndex")
+		startIndexes = append(startIndexes, startIndex)
+		fmt.Fprintf(w, `{"schemas": ["%s"], "totalResults": 2, "startIndex": %s, "itemsPerPage": 1, "Resources": [{"id": "user_%s", "userName": "jane@example.com", "active": true}]}`,
+			scim.ListResponseSchema, startIndex, startIndex)
+	}))
+	defer server.Close()
+
+	users, err := newSCIMClient(t, server).ListUsers(context.Background(), &scim.ListOpts{
+		Filter: scim.Eq("emails.value", "jane@example.com"),
+		Count:  1,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, users, 2)
+	assert.Equal(t, "user_2", users[1].ID)
+	assert.Equal(t, []string{"1", "2"}, startIndexes)
+}
+
+func TestCreateUser_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/scim/v2/Users", r.URL.Path)
+		assert.Equal(t, "application/scim+json", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"], "externalId": "00u1", "userName": "jane@example.com", "name": {"givenName": "Jane", "familyName": "Doe"}, "emails": [{"value": "jane@example.com", "type": "work", "primary": true}], "active": true}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"], "id": "user_1", "externalId": "00u1", "userName": "jane@example.com", "active": true, "meta": {"resourceType": "User"}}`)
+	}))
+	defer server.Close()
+
+	user, err := newSCIMClient(t, server).CreateUser(context.Background(), &scim.User{
+		ExternalID: "00u1",
+		UserName:   "jane@example.com",
+		Name:       &scim.Name{GivenName: "Jane", FamilyName: "Doe"},
+		Emails:     []scim.Email{{Value: "jane@example.com", Type: "work", Primary: true}},
+		Active:     true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "user_1", user.ID)
+	assert.Equal(t, "User", user.Meta.ResourceType)
+}
+
+func TestCreateUser_Conflict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprintln(w, `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:Error"], "status": "409", "scimType": "uniqueness", "detail": "User already exists"}`)
+	}))
+	defer server.Close()
+
+	_, err := newSCIMClient(t, server).CreateUser(context.Background(), &scim.User{UserName: "jane@example.com", Active: true})
+	assert.EqualError(t, err, "uniqueness: User already exists")
+	assert.True(t, errors.Is(err, retool.ErrConflict))
+
+	var scimErr *scim.Error
+	if assert.True(t, errors.As(err, &scimErr)) {
+		assert.Equal(t, "uniqueness", scimErr.ScimType)
+		assert.Equal(t, http.StatusConflict, scimErr.Err.StatusCode)
+	}
+}
+
+func TestCreateUser_Invalid(t *testing.T) {
+	client, err := retool.NewClient("scim-token", "https://retool.example.com")
+	assert.NoError(t, err)
+	scimClient, err := scim.NewClient(client)
+	assert.NoError(t, err)
+
+	_, err = scimClient.CreateUser(context.Background(), nil)
+	assert.EqualError(t, err, "user cannot be nil")
+
+	_, err = scimClient.CreateUser(context.Background(), &scim.User{})
+	assert.EqualError(t, err, "user name is required")
+
+	_, err = scimClient.PatchUser(context.Background(), "user_1", nil)
+	assert.EqualError(t, err, "no operations provided")
+
+	_, err = scimClient.PatchUser(context.Background(), "user_1", []scim.PatchOperation{{Op: "move", Path: "active"}})
+	assert.EqualError(t, err, "validation failed for operation: invalid patch operation: move")
+}
+
+func TestPatchUser_Deactivate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/api/scim/v2/Users/user_1", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "replace", "path": "active", "value": false}]}`, string(body))
+		fmt.Fprintln(w, `{"id": "user_1", "userName": "jane@example.com", "active": false}`)
+	}))
+	defer server.Close()
+
+	user, err := newSCIMClient(t, server).PatchUser(context.Background(), "user_1", []scim.PatchOperation{scim.SetActive(false)})
+	assert.NoError(t, err)
+	assert.False(t, user.Active)
+}
+
+func TestDeleteUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api/scim/v2/Users/user_1", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	assert.NoError(t, newSCIMClient(t, server).DeleteUser(context.Background(), "user_1"))
+}
+
+func TestUser_PrimaryEmail(t *testing.T) {
+	user := scim.User{Emails: []scim.Email{{Value: "jane@home.example.com"}, {Value: "jane@example.com", Primary: true}}}
+	assert.Equal(t, "jane@example.com", user.PrimaryEmail())
+
+	user.Emails[1].Primary = false
+	assert.Equal(t, "jane@home.example.com", user.PrimaryEmail())
+}
@@ -0,0 +1,447 @@
+package retoolsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// ErrDeployFailed is returned by TriggerDeploy when it waits for a deploy that does not succeed.
+var ErrDeployFailed = errors.New("deploy failed")
+
+// Source control providers.
+const (
+	SourceControlGitHub        = "GitHub"
+	SourceControlGitLab        = "GitLab"
+	SourceControlBitbucket     = "Bitbucket"
+	SourceControlAWSCodeCommit = "AWS CodeCommit"
+	SourceControlAzureRepos    = "Azure Repos"
+)
+
+// GitHub authentication types of SourceControlCredentials.
+const (
+	GitHubApp      = "App"
+	GitHubPersonal = "Personal"
+)
+
+// SourceControlConfig is the Git repository Retool syncs apps, workflows and resources with.
+type SourceControlConfig struct {
+	Provider      string                   `json:"provider"`
+	Org           string                   `json:"org"`
+	Repo          string                   `json:"repo"`
+	DefaultBranch string                   `json:"default_branch"`
+	RepoVersion   string                   `json:"repo_version,omitempty"`
+	Config        SourceControlCredentials `json:"config"`
+}
+
+// Validate checks that the repository is identified and that the credentials required by the provider are set.
+func (c *SourceControlConfig) Validate() error {
+	if c.Org == "" {
+		return errors.New("org is required")
+	}
+	if c.Repo == "" {
+		return errors.New("repo is required")
+	}
+	if c.DefaultBranch == "" {
+		return errors.New("default branch is required")
+	}
+
+	return c.Config.validate(c.Provider)
+}
+
+// SourceControlCredentials holds the provider-specific connection settings of a SourceControlConfig. Only the
+// fields of the configured provider are used. The secrets are redacted when the credentials are formatted.
+type SourceControlCredentials struct {
+	// Type is the GitHub authentication type, GitHubApp or GitHubPersonal.
+	Type string `json:"type,omitempty"`
+	// URL is the base URL of self-hosted GitHub Enterprise, GitLab, Bitbucket or Azure Repos instances.
+	URL              string `json:"url,omitempty"`
+	EnterpriseAPIURL string `json:"enterprise_api_url,omitempty"`
+
+	AppID          string `json:"app_id,omitempty"`
+	InstallationID string `json:"installation_id,omitempty"`
+	PrivateKey     string `json:"private_key,omitempty"`
+
+	PersonalAccessToken string `json:"personal_access_token,omitempty"`
+
+	ProjectID          string `json:"project_id,omitempty"`
+	ProjectAccessToken string `json:"project_access_token,omitempty"`
+
+	Username    string `json:"username,omitempty"`
+	AppPassword string `json:"app_password,omitempty"`
+
+	Region          string `json:"region,omitempty"`
+	AccessKeyID     string `json:"access_key_id,omitempty"`
+	SecretAccessKey string `json:"secret_access_key,omitempty"`
+	HTTPSUsername   string `json:"https_username,omitempty"`
+	HTTPSPassword   string `json:"https_password,omitempty"`
+
+	Project string `json:"project,omitempty"`
+	User    string `json:"user,omitempty"`
+}
+
+// credential is a named credential field checked by SourceControlCredentials.validate.
+type credential struct {
+	name, value string
+}
+
+// validate checks that the credentials required by the provider are set.
+func (c SourceControlCredentials) validate(provider string) error {
+	var required []credential
+
+	switch provider {
+	case SourceControlGitHub:
+		switch c.Type {
+		case GitHubApp:
+			required = []credential{{"app id", c.AppID}, {"installation id", c.InstallationID}, {"private key", c.PrivateKey}}
+		case GitHubPersonal:
+			required = []credential{{"personal access token", c.PersonalAccessToken}}
+		default:
+			return fmt.Errorf("invalid github authentication type: %s", c.Type)
+		}
+	case SourceControlGitLab:
+		required = []credential{{"url", c.URL}, {"project id", c.ProjectID}, {"project access token", c.ProjectAccessToken}}
+	case SourceControlBitbucket:
+		required = []credential{{"username", c.Username}, {"app password", c.AppPassword}}
+	case SourceControlAWSCodeCommit:
+		required = []credential{{"region", c.Region}, {"access key id", c.AccessKeyID}, {"secret access key", c.SecretAccessKey},
+			{"https username", c.HTTPSUsername}, {"https password", c.HTTPSPassword}}
+	case SourceControlAzureRepos:
+		required = []credential{{"url", c.URL}, {"project", c.Project}, {"user", c.User}, {"personal access token", c.PersonalAccessToken}}
+	default:
+		return fmt.Errorf("invalid source control provider: %s", provider)
+	}
+
+	for _, field := range required {
+		if field.value == "" {
+			return fmt.Errorf("%s is required for %s", field.name, provider)
+		}
+	}
+
+	return nil
+}
+
+// String formats the credentials with the secrets redacted.
+func (c SourceControlCredentials) String() string {
+	return fmt.Sprintf("SourceControlCredentials{Type:%s URL:%s EnterpriseAPIURL:%s AppID:%s InstallationID:%s PrivateKey:%s "+
+		"PersonalAccessToken:%s ProjectID:%s ProjectAccessToken:%s Username:%s AppPassword:%s Region:%s AccessKeyID:%s "+
+		"SecretAccessKey:%s HTTPSUsername:%s HTTPSPassword:%s Project:%s User:%s}",
+		c.Type, c.URL, c.EnterpriseAPIURL, c.AppID, c.InstallationID, secret(c.PrivateKey),
+		secret(c.PersonalAccessToken), c.ProjectID, secret(c.ProjectAccessToken), c.Username, secret(c.AppPassword), c.Region,
+		c.AccessKeyID, secret(c.SecretAccessKey), c.HTTPSUsername, secret(c.HTTPSPassword), c.Project, c.User)
+}
+
+// GoString formats the credentials for %#v with the secrets redacted.
+func (c SourceControlCredentials) GoString() string { return c.String() }
+
+// SourceControlSettings are the organization-wide source control settings.
+type SourceControlSettings struct {
+	AutoBranchNamingEnabled          bool   `json:"auto_branch_naming_enabled"`
+	CustomPullRequestTemplateEnabled bool   `json:"custom_pull_request_template_enabled"`
+	CustomPullRequestTemplate        string `json:"custom_pull_request_template,omitempty"`
+	VersionControlLocked             bool   `json:"version_control_locked"`
+}
+
+// ConnectionTestResult is the result of testing the connection to an external service.
+type ConnectionTestResult struct {
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+}
+
+// Branch is a branch of the source control repository.
+type Branch struct {
+	Name      string `json:"name"`
+	CommitSHA string `json:"commit_sha"`
+	IsDefault bool   `json:"is_default"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// Commit is a commit of the source control repository.
+type Commit struct {
+	SHA         string `json:"sha"`
+	Message     string `json:"message"`
+	Author      string `json:"author"`
+	AuthorEmail string `json:"author_email"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// Deploy is a deploy of the source control repository to the Retool instance.
+type Deploy struct {
+	ID          string `json:"id"`
+	Status      string `json:"status"`
+	Branch      string `json:"branch"`
+	CommitSHA   string `json:"commit_sha"`
+	TriggeredBy string `json:"triggered_by,omitempty"`
+	Error       string `json:"error,omitempty"`
+	CreatedAt   string `json:"created_at"`
+	CompletedAt string `json:"completed_at,omitempty"`
+}
+
+// Deploy statuses.
+const (
+	DeployPending    = "pending"
+	DeployInProgress = "in_progress"
+	DeploySuccess    = "success"
+	DeployFailure    = "failure"
+	DeployCancelled  = "cancelled"
+	DeployErrored    = "errored"
+)
+
+// Done reports whether the deploy has finished, successfully or not. Any status other than pending or in progress is
+// treated as finished, so that waiting stops on statuses added to the API later.
+func (d *Deploy) Done() bool {
+	return d.Status != DeployPending && d.Status != DeployInProgress
+}
+
+// GetSourceControlConfig returns the source control configuration of the organization.
+// The API token must have the "Source Control > Read" scope.
+func (c *Client) GetSourceControlConfig(ctx context.Context) (*SourceControlConfig, error) {
+	baseURL := fmt.Sprintf("%s/source_control/config", c.BaseURL)
+	return doSingleRequest[SourceControlConfig](ctx, c, "GET", baseURL, nil)
+}
+
+// UpdateSourceControlConfig replaces the source control configuration of the organization and returns it.
+// The configuration is validated before it is sent. The API token must have the "Source Control > Write" scope.
+func (c *Client) UpdateSourceControlConfig(ctx context.Context, config *SourceControlConfig) (*SourceControlConfig, error) {
+	if config == nil {
+		return nil, errors.New("source control config cannot be nil")
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("validating source control config: %w", err)
+	}
+
+	requestBodyJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	baseURL := fmt.Sprintf("%s/source_control/config", c.BaseURL)
+	return doSingleRequest[SourceControlConfig](ctx, c, "PUT", baseURL, requestBodyJSON)
+}
+
+// GetSourceControlSettings returns the source control settings of the organization.
+// The API token must have the "Source Control > Read" scope.
+func (c *Client) GetSourceControlSettings(ctx context.Context) (*SourceControlSettings, error) {
+	baseURL := fmt.Sprintf("%s/source_control/settings", c.BaseURL)
+	return doSingleRequest[SourceControlSettings](ctx, c, "GET", baseURL, nil)
+}
+
+// UpdateSourceControlSettings replaces the source control settings of the organization and returns them.
+// The API token must have the "Source Control > Write" scope.
+func (c *Client) UpdateSourceControlSettings(ctx context.Context, settings SourceControlSettings) (*SourceControlSettings, error) {
+	requestBodyJSON, err := json.Marshal(settings)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	baseURL := fmt.Sprintf("%s/source_control/settings", c.BaseURL)
+	return doSingleRequest[SourceControlSettings](ctx, c, "PUT", baseURL, requestBodyJSON)
+}
+
+// TestSourceControlConnection checks that Retool can reach the repository with the configuration, or with the saved
+// configuration when config is nil. A failed connection is reported in the result, not as an error.
+// The API token must have the "Source Control > Write" scope.
+func (c *Client) TestSourceControlConnection(ctx context.Context, config *SourceControlConfig) (*ConnectionTestResult, error) {
+	var requestBodyJSON []byte
+	if config != nil {
+		if err := config.Validate(); err != nil {
+			return nil, fmt.Errorf("validating source control config: %w", err)
+		}
+
+		var err error
+		requestBodyJSON, err = json.Marshal(config)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling request: %w", err)
+		}
+	}
+
+	baseURL := fmt.Sprintf("%s/source_control/test_connection", c.BaseURL)
+	return doSingleRequest[ConnectionTestResult](ctx, c, "POST", baseURL, requestBodyJSON)
+}
+
+// ListBranches returns the branches of the source control repository.
+// The API token must have the "Source Control > Read" scope.
+func (c *Client) ListBranches(ctx context.Context) ([]Branch, error) {
+	baseURL := fmt.Sprintf("%s/source_control/branches", c.BaseURL)
+	return doPaginatedRequest[Branch](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllBranches returns an iterator over the branches of the source control repository, fetching pages lazily as
+// the iteration progresses. The API token must have the "Source Control > Read" scope.
+func (c *Client) AllBranches(ctx context.Context) iter.Seq2[Branch, error] {
+	return paginateItems(c.BranchPages(ctx, ""))
+}
+
+// BranchPages returns an iterator over the pages of branches, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Source Control > Read" scope.
+func (c *Client) BranchPages(ctx context.Context, next string) iter.Seq2[*Page[Branch], error] {
+	baseURL := fmt.Sprintf("%s/source_control/branches", c.BaseURL)
+	return paginatePages[Branch](ctx, c, "GET", baseURL, nil, url.Values{}, next)
+}
+
+// CreateBranch creates a branch from the source branch, or from the default branch when source is empty, and
+// returns it. The API token must have the "Source Control > Write" scope.
+func (c *Client) CreateBranch(ctx context.Context, name, source string) (*Branch, error) {
+	if name == "" {
+		return nil, errors.New("branch name cannot be empty")
+	}
+
+	requestBody := struct {
+		Name         string `json:"name"`
+		SourceBranch string `json:"source_branch,omitempty"`
+	}{
+		Name:         name,
+		SourceBranch: source,
+	}
+
+	requestBodyJSON, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	baseURL := fmt.Sprintf("%s/source_control/branches", c.BaseURL)
+	return doSingleRequest[Branch](ctx, c, "POST", baseURL, requestBodyJSON)
+}
+
+// DeleteBranch deletes the branch with the given name. The API token must have the "Source Control > Write" scope.
+func (c *Client) DeleteBranch(ctx context.Context, name string) error {
+	if name == "" {
+		return errors.New("branch name cannot be empty")
+	}
+
+	baseURL := fmt.Sprintf("%s/source_control/branches/%s", c.BaseURL, url.PathEscape(name))
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
+	return err
+}
+
+// ListCommitsOpts is a struct that contains optional query parameters for ListCommits.
+type ListCommitsOpts struct {
+	// Branch lists the commits of the branch instead of the default branch.
+	Branch string
+}
+
+// values returns the query parameters for the options.
+func (o *ListCommitsOpts) values() url.Values {
+	query := make(url.Values)
+
+	if o == nil {
+		return query
+	}
+
+	if o.Branch != "" {
+		query.Add("branch", o.Branch)
+	}
+
+	return query
+}
+
+// ListCommits returns the commits of a branch of the source control repository, most recent first.
+// The API token must have the "Source Control > Read" scope.
+func (c *Client) ListCommits(ctx context.Context, opts *ListCommitsOpts) ([]Commit, error) {
+	baseURL := fmt.Sprintf("%s/source_control/commits", c.BaseURL)
+	return doPaginatedRequest[Commit](ctx, c, "GET", baseURL, nil, opts.values())
+}
+
+// AllCommits returns an iterator over the commits of a branch, fetching pages lazily as the iteration progresses.
+// The API token must have the "Source Control > Read" scope.
+func (c *Client) AllCommits(ctx context.Context, opts *ListCommitsOpts) iter.Seq2[Commit, error] {
+	return paginateItems(c.CommitPages(ctx, opts, ""))
+}
+
+// CommitPages returns an iterator over the pages of commits of a branch, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Source Control > Read" scope.
+func (c *Client) CommitPages(ctx context.Context, opts *ListCommitsOpts, next string) iter.Seq2[*Page[Commit], error] {
+	baseURL := fmt.Sprintf("%s/source_control/commits", c.BaseURL)
+	return paginatePages[Commit](ctx, c, "GET", baseURL, nil, opts.values(), next)
+}
+
+// TriggerDeployOpts is a struct that contains optional parameters for TriggerDeploy.
+type TriggerDeployOpts struct {
+	// Wait polls the deploy until it finishes and returns the finished deploy.
+	Wait bool
+	// PollInterval is the time between polls when waiting. It defaults to 2 seconds.
+	PollInterval time.Duration
+}
+
+// TriggerDeploy deploys the latest commit of the default branch to the Retool instance and returns the deploy.
+// With opts.Wait it polls the deploy until it finishes or ctx is done; when the finished deploy did not succeed
+// the deploy is returned together with an error wrapping ErrDeployFailed.
+// The API token must have the "Source Control > Write" scope.
+func (c *Client) TriggerDeploy(ctx context.Context, opts *TriggerDeployOpts) (*Deploy, error) {
+	baseURL := fmt.Sprintf("%s/source_control/deploys", c.BaseURL)
+	deploy, err := doSingleRequest[Deploy](ctx, c, "POST", baseURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if deploy == nil {
+		return nil, errors.New("triggering deploy: empty response")
+	}
+
+	if opts == nil || !opts.Wait {
+		return deploy, nil
+	}
+
+	return c.waitForDeploy(ctx, deploy, opts.PollInterval)
+}
+
+// waitForDeploy polls the deploy every interval until it finishes.
+func (c *Client) waitForDeploy(ctx context.Context, deploy *Deploy, interval time.Duration) (*Deploy, error) {
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+
+	for !deploy.Done() {
+		if err := sleepContext(ctx, interval); err != nil {
+			return deploy, fmt.Errorf("waiting for deploy %s: %w", deploy.ID, err)
+		}
+
+		next, err := c.GetDeploy(ctx, deploy.ID)
+		if err != nil {
+			return deploy, fmt.Errorf("waiting for deploy %s: %w", deploy.ID, err)
+		}
+		if next == nil {
+			return deploy, fmt.Errorf("waiting for deploy %s: empty response", deploy.ID)
+		}
+		deploy = next
+	}
+
+	if deploy.Status != DeploySuccess {
+		if deploy.Error != "" {
+			return deploy, fmt.Errorf("%w: deploy %s finished with status %s: %s", ErrDeployFailed, deploy.ID, deploy.Status, deploy.Error)
+		}
+		return deploy, fmt.Errorf("%w: deploy %s finished with status %s", ErrDeployFailed, deploy.ID, deploy.Status)
+	}
+
+	return deploy, nil
+}
+
+// ListDeploys returns the deploys of the source control repository, most recent first.
+// The API token must have the "Source Control > Read" scope.
+func (c *Client) ListDeploys(ctx context.Context) ([]Deploy, error) {
+	baseURL := fmt.Sprintf("%s/source_control/deploys", c.BaseURL)
+	return doPaginatedRequest[Deploy](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllDeploys returns an iterator over the deploys, fetching pages lazily as the iteration progresses.
+// The API token must have the "Source Control > Read" scope.
+func (c *Client) AllDeploys(ctx context.Context) iter.Seq2[Deploy, error] {
+	return paginateItems(c.DeployPages(ctx, ""))
+}
+
+// DeployPages returns an iterator over the pages of deploys, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Source Control > Read" scope.
+func (c *Client) DeployPages(ctx context.Context, next string) iter.Seq2[*Page[Deploy], error] {
+	baseURL := fmt.Sprintf("%s/source_control/deploys", c.BaseURL)
+	return paginatePages[Deploy](ctx, c, "GET", baseURL, nil, url.Values{}, next)
+}
+
+// GetDeploy returns the deploy with the given ID. The API token must have the "Source Control > Read" scope.
+func (c *Client) GetDeploy(ctx context.Context, id string) (*Deploy, error) {
+	baseURL := fmt.Sprintf("%s/source_control/deploys/%s", c.BaseURL, id)
+	return doSingleRequest[Deploy](ctx, c, "GET", baseURL, nil)
+}
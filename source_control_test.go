@@ -0,0 +1,275 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourceControlConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config retool.SourceControlConfig
+		err    string
+	}{
+		{"github app", retool.SourceControlConfig{Provider: retool.SourceControlGitHub, Org: "acme", Repo: "retool", DefaultBranch: "main",
+			Config: retool.SourceControlCredentials{Type: retool.GitHubApp, AppID: "1", InstallationID: "2", PrivateKey: "key"}}, ""},
+		{"missing repo", retool.SourceControlConfig{Provider: retool.SourceControlGitHub, Org: "acme"}, "repo is required"},
+		{"invalid provider", retool.SourceControlConfig{Provider: "svn", Org: "acme", Repo: "retool", DefaultBranch: "main"}, "invalid source control provider: svn"},
+		{"github type", retool.SourceControlConfig{Provider: retool.SourceControlGitHub, Org: "acme", Repo: "retool", DefaultBranch: "main"}, "invalid github authentication type: "},
+		{"gitlab token", retool.SourceControlConfig{Provider: retool.SourceControlGitLab, Org: "acme", Repo: "retool", DefaultBranch: "main",
+			Config: retool.SourceControlCredentials{URL: "https://gitlab.example.com", ProjectID: "42"}}, "project access token is required for GitLab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestSourceControlCredentials_StringRedactsSecrets(t *testing.T) {
+	credentials := retool.SourceControlCredentials{Type: retool.GitHubApp, AppID: "123", PrivateKey: "key-secret", PersonalAccessToken: "token-secret"}
+
+	output := fmt.Sprintf("%s %v %+v %#v", credentials, credentials, credentials, credentials)
+	assert.NotContains(t, output, "secret")
+	assert.Contains(t, output, "AppID:123")
+	assert.Contains(t, output, "[REDACTED]")
+}
+
+func TestGetSourceControlConfig_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/source_control/config", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"provider": "GitLab", "org": "acme", "repo": "retool", "default_branch": "main", "config": {"url": "https://gitlab.example.com", "project_id": "42"}}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	config, err := client.GetSourceControlConfig(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, retool.SourceControlGitLab, config.Provider)
+	assert.Equal(t, "42", config.Config.ProjectID)
+}
+
+func TestUpdateSourceControlConfig_ValidationFailure(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://example.com")
+	assert.NoError(t, err)
+
+	_, err = client.UpdateSourceControlConfig(context.Background(), &retool.SourceControlConfig{
+		Provider: retool.SourceControlBitbucket, Org: "acme", Repo: "retool", DefaultBranch: "main",
+		Config: retool.SourceControlCredentials{Username: "retool"},
+	})
+	assert.EqualError(t, err, "validating source control config: app password is required for Bitbucket")
+
+	_, err = client.UpdateSourceControlConfig(context.Background(), nil)
+	assert.EqualError(t, err, "source control config cannot be nil")
+}
+
+func TestUpdateSourceControlSettings_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/api/v2/source_control/settings", r.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, true, body["version_control_locked"])
+
+		fmt.Fprintln(w, `{"success": true, "data": {"auto_branch_naming_enabled": false, "version_control_locked": true}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	settings, err := client.UpdateSourceControlSettings(context.Background(), retool.SourceControlSettings{VersionControlLocked: true})
+	assert.NoError(t, err)
+	assert.True(t, settings.VersionControlLocked)
+}
+
+func TestTestSourceControlConnection_SavedConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/source_control/test_connection", r.URL.Path)
+		assert.Equal(t, int64(0), r.ContentLength)
+		fmt.Fprintln(w, `{"success": true, "data": {"success": false, "message": "Bad credentials"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	result, err := client.TestSourceControlConnection(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &retool.ConnectionTestResult{Success: false, Message: "Bad credentials"}, result)
+}
+
+func TestBranches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET /api/v2/source_control/branches":
+			fmt.Fprintln(w, `{"success": true, "data": [{"name": "main", "commit_sha": "abc123", "is_default": true}], "total_count": 1, "has_more": false}`)
+		case "POST /api/v2/source_control/branches":
+			var body map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]string{"name": "feature/login", "source_branch": "main"}, body)
+			fmt.Fprintln(w, `{"success": true, "data": {"name": "feature/login", "commit_sha": "abc123"}}`)
+		case "DELETE /api/v2/source_control/branches/feature%2Flogin":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+		}
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	branches, err := client.ListBranches(ctx)
+	assert.NoError(t, err)
+	assert.True(t, branches[0].IsDefault)
+
+	branch, err := client.CreateBranch(ctx, "feature/login", "main")
+	assert.NoError(t, err)
+	assert.Equal(t, "feature/login", branch.Name)
+
+	assert.NoError(t, client.DeleteBranch(ctx, "feature/login"))
+	assert.EqualError(t, client.DeleteBranch(ctx, ""), "branch name cannot be empty")
+}
+
+func TestListCommits_Branch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/source_control/commits", r.URL.Path)
+		assert.Equal(t, "feature/login", r.URL.Query().Get("branch"))
+		fmt.Fprintln(w, `{"success": true, "data": [{"sha": "def456", "message": "Add login page", "author": "Jane"}], "total_count": 1, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	commits, err := client.ListCommits(context.Background(), &retool.ListCommitsOpts{Branch: "feature/login"})
+	assert.NoError(t, err)
+	assert.Equal(t, "def456", commits[0].SHA)
+}
+
+func TestTriggerDeploy_Wait(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v2/source_control/deploys":
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "deploy_1", "status": "pending"}}`)
+		case "GET /api/v2/source_control/deploys/deploy_1":
+			if polls.Add(1) < 2 {
+				fmt.Fprintln(w, `{"success": true, "data": {"id": "deploy_1", "status": "in_progress"}}`)
+				return
+			}
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "deploy_1", "status": "success", "commit_sha": "abc123"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	deploy, err := client.TriggerDeploy(context.Background(), &retool.TriggerDeployOpts{Wait: true, PollInterval: time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, retool.DeploySuccess, deploy.Status)
+	assert.Equal(t, int32(2), polls.Load())
+}
+
+func TestTriggerDeploy_Failed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "deploy_2", "status": "failure", "error": "merge conflict"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	deploy, err := client.TriggerDeploy(context.Background(), &retool.TriggerDeployOpts{Wait: true})
+	assert.ErrorIs(t, err, retool.ErrDeployFailed)
+	assert.EqualError(t, err, "deploy failed: deploy deploy_2 finished with status failure: merge conflict")
+	assert.Equal(t, "deploy_2", deploy.ID)
+}
+
+func TestTriggerDeploy_Cancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "deploy_3", "status": "cancelled"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	deploy, err := client.TriggerDeploy(context.Background(), &retool.TriggerDeployOpts{Wait: true, PollInterval: time.Millisecond})
+	assert.ErrorIs(t, err, retool.ErrDeployFailed)
+	assert.EqualError(t, err, "deploy failed: deploy deploy_3 finished with status cancelled")
+	assert.True(t, deploy.Done())
+}
+
+func TestTriggerDeploy_NoContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	deploy, err := client.TriggerDeploy(context.Background(), &retool.TriggerDeployOpts{Wait: true, PollInterval: time.Millisecond})
+	assert.EqualError(t, err, "triggering deploy: empty response")
+	assert.Nil(t, deploy)
+}
+
+func TestTriggerDeploy_WaitNoContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "deploy_1", "status": "pending"}}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	deploy, err := client.TriggerDeploy(context.Background(), &retool.TriggerDeployOpts{Wait: true, PollInterval: time.Millisecond})
+	assert.EqualError(t, err, "waiting for deploy deploy_1: empty response")
+	assert.Equal(t, "deploy_1", deploy.ID)
+}
+
+func TestListDeploys_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/source_control/deploys", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "deploy_2", "status": "failure"}, {"id": "deploy_1", "status": "success"}], "total_count": 2, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	deploys, err := client.ListDeploys(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, deploys, 2)
+	assert.True(t, deploys[0].Done())
+}
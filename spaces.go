@@ -1,8 +1,10 @@
 package retoolsdk
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 )
 
@@ -15,19 +17,32 @@ type Space struct {
 }
 
 // GetSpace Available for orgs with Spaces enabled. Get space by ID. The API token must have the "Spaces > Read" scope.
-func (c *Client) GetSpace(id string) (*Space, error) {
+func (c *Client) GetSpace(ctx context.Context, id string) (*Space, error) {
 	baseURL := fmt.Sprintf("%s/spaces/%s", c.BaseURL, id)
-	return doSingleRequest[Space](c, "GET", baseURL, nil)
+	return doSingleRequest[Space](ctx, c, "GET", baseURL, nil)
 }
 
 // ListSpaces Available for orgs with Spaces enabled. List all child spaces of the current space. The API token must have the "Spaces > Read" scope.
-func (c *Client) ListSpaces() ([]Space, error) {
+func (c *Client) ListSpaces(ctx context.Context) ([]Space, error) {
 	baseURL := fmt.Sprintf("%s/spaces", c.BaseURL)
-	return doPaginatedRequest[Space](c, "GET", baseURL, nil, url.Values{})
+	return doPaginatedRequest[Space](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllSpaces returns an iterator over all child spaces, fetching pages lazily as the iteration progresses.
+// Available for orgs with Spaces enabled. The API token must have the "Spaces > Read" scope.
+func (c *Client) AllSpaces(ctx context.Context) iter.Seq2[Space, error] {
+	return paginateItems(c.SpacePages(ctx, ""))
+}
+
+// SpacePages returns an iterator over the pages of child spaces, starting at the page identified by next
+// (or the first page when empty). Available for orgs with Spaces enabled. The API token must have the "Spaces > Read" scope.
+func (c *Client) SpacePages(ctx context.Context, next string) iter.Seq2[*Page[Space], error] {
+	baseURL := fmt.Sprintf("%s/spaces", c.BaseURL)
+	return paginatePages[Space](ctx, c, "GET", baseURL, nil, url.Values{}, next)
 }
 
 // UpdateSpace Available for orgs with Spaces enabled. Update space by ID. The API token must have the "Spaces > Write" scope.
-func (c *Client) UpdateSpace(id, name, domain string) (*Space, error) {
+func (c *Client) UpdateSpace(ctx context.Context, id, name, domain string) (*Space, error) {
 	requestBody := struct {
 		Name   string `json:"name"`
 		Domain string `json:"domain"`
@@ -42,7 +57,7 @@ func (c *Client) UpdateSpace(id, name, domain string) (*Space, error) {
 	}
 
 	baseURL := fmt.Sprintf("%s/spaces/%s", c.BaseURL, id)
-	return doSingleRequest[Space](c, "PUT", baseURL, requestBodyJSON)
+	return doSingleRequest[Space](ctx, c, "PUT", baseURL, requestBodyJSON)
 }
 
 // CreateSpaceOptions is a struct that contains optional parameters for CreateSpace.
@@ -54,7 +69,7 @@ type CreateSpaceOptions struct {
 }
 
 // CreateSpace Available for orgs with Spaces enabled. Creates a new child space and returns it. The API token must have the "Spaces > Write" scope.
-func (c *Client) CreateSpace(name, domain string, options *CreateSpaceOptions) (*Space, error) {
+func (c *Client) CreateSpace(ctx context.Context, name, domain string, options *CreateSpaceOptions) (*Space, error) {
 	requestBody := struct {
 		Name    string              `json:"name"`
 		Domain  string              `json:"domain"`
@@ -71,12 +86,12 @@ func (c *Client) CreateSpace(name, domain string, options *CreateSpaceOptions) (
 	}
 
 	baseURL := fmt.Sprintf("%s/spaces", c.BaseURL)
-	return doSingleRequest[Space](c, "POST", baseURL, requestBodyJSON)
+	return doSingleRequest[Space](ctx, c, "POST", baseURL, requestBodyJSON)
 }
 
 // DeleteSpace Available for orgs with Spaces enabled. Delete a space by ID. The API token must have the "Spaces > Write" scope.
-func (c *Client) DeleteSpace(id string) error {
+func (c *Client) DeleteSpace(ctx context.Context, id string) error {
 	baseURL := fmt.Sprintf("%s/spaces/%s", c.BaseURL, id)
-	_, err := doSingleRequest[any](c, "DELETE", baseURL, nil)
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
 	return err
 }
@@ -2,6 +2,7 @@ package retoolsdk_test
 
 import (
 	"bytes"
+	"context"
 	"github.com/stretchr/testify/assert"
 	"github.com/thoughtgears/retoolsdk"
 	"io"
@@ -31,7 +32,7 @@ func TestGetSpace_Success(t *testing.T) {
 		},
 	}
 
-	space, err := client.GetSpace("space_123")
+	space, err := client.GetSpace(context.Background(), "space_123")
 
 	assert.NoError(t, err)
 	assert.NotNil(t, space)
@@ -58,7 +59,7 @@ func TestGetSpace_Failure(t *testing.T) {
 		},
 	}
 
-	space, err := client.GetSpace("non_existing_space")
+	space, err := client.GetSpace(context.Background(), "non_existing_space")
 
 	assert.Error(t, err)
 	assert.Nil(t, space)
@@ -95,7 +96,7 @@ func TestListSpaces_Success(t *testing.T) {
 		},
 	}
 
-	spaces, err := client.ListSpaces()
+	spaces, err := client.ListSpaces(context.Background())
 
 	assert.NoError(t, err)
 	assert.Len(t, spaces, 2)
@@ -121,7 +122,7 @@ func TestListSpaces_Failure(t *testing.T) {
 		},
 	}
 
-	spaces, err := client.ListSpaces()
+	spaces, err := client.ListSpaces(context.Background())
 
 	assert.Error(t, err)
 	assert.Nil(t, spaces)
@@ -150,7 +151,7 @@ func TestUpdateSpace_Success(t *testing.T) {
 		},
 	}
 
-	space, err := client.UpdateSpace("space_123", "Updated Space", "updated-domain")
+	space, err := client.UpdateSpace(context.Background(), "space_123", "Updated Space", "updated-domain")
 
 	assert.NoError(t, err)
 	assert.NotNil(t, space)
@@ -176,7 +177,7 @@ func TestUpdateSpace_Failure(t *testing.T) {
 		},
 	}
 
-	space, err := client.UpdateSpace("space_123", "Invalid Space", "")
+	space, err := client.UpdateSpace(context.Background(), "space_123", "Invalid Space", "")
 
 	assert.Error(t, err)
 	assert.Nil(t, space)
@@ -212,7 +213,7 @@ func TestCreateSpace_Success(t *testing.T) {
 		CreateAdminUser:              true,
 	}
 
-	space, err := client.CreateSpace("New Space", "new-domain", options)
+	space, err := client.CreateSpace(context.Background(), "New Space", "new-domain", options)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, space)
@@ -243,7 +244,7 @@ func TestCreateSpace_Failure(t *testing.T) {
 		CopyBrandingAndThemeSettings: false,
 	}
 
-	space, err := client.CreateSpace("Test Space", "invalid_domain", options)
+	space, err := client.CreateSpace(context.Background(), "Test Space", "invalid_domain", options)
 
 	assert.Error(t, err)
 	assert.Nil(t, space)
@@ -267,7 +268,7 @@ func TestDeleteSpace_Success(t *testing.T) {
 		},
 	}
 
-	err := client.DeleteSpace("space_123")
+	err := client.DeleteSpace(context.Background(), "space_123")
 
 	assert.NoError(t, err)
 }
@@ -290,7 +291,7 @@ func TestDeleteSpace_Failure(t *testing.T) {
 		},
 	}
 
-	err := client.DeleteSpace("non_existing_space")
+	err := client.DeleteSpace(context.Background(), "non_existing_space")
 
 	assert.Error(t, err)
 	assert.EqualError(t, err, "Space not found")
@@ -0,0 +1,284 @@
+package retoolsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// SSO configuration types.
+const (
+	SSOConfigOIDC   = "oidc"
+	SSOConfigSAML   = "saml"
+	SSOConfigGoogle = "google"
+)
+
+// SSOConfig is the single sign-on configuration of an organization or space. The implementations in this package
+// never include client secrets in their String output, so configurations can be logged and printed safely.
+type SSOConfig interface {
+	// ConfigType returns the type of single sign-on the configuration sets up.
+	ConfigType() string
+	// Validate ensures that the required fields are set and valid.
+	Validate() error
+	fmt.Stringer
+}
+
+// OIDCConfig configures single sign-on with an OpenID Connect identity provider.
+type OIDCConfig struct {
+	Issuer                    string `json:"oidc_issuer"`
+	ClientID                  string `json:"oidc_client_id"`
+	ClientSecret              string `json:"oidc_client_secret,omitempty"`
+	Scopes                    string `json:"oidc_scopes,omitempty"`
+	AuthURL                   string `json:"oidc_auth_url,omitempty"`
+	TokenURL                  string `json:"oidc_token_url,omitempty"`
+	UserInfoURL               string `json:"oidc_userinfo_url,omitempty"`
+	Audience                  string `json:"oidc_audience,omitempty"`
+	JWTEmailKey               string `json:"jwt_email_key,omitempty"`
+	JWTRolesKey               string `json:"jwt_roles_key,omitempty"`
+	JWTFirstNameKey           string `json:"jwt_first_name_key,omitempty"`
+	JWTLastNameKey            string `json:"jwt_last_name_key,omitempty"`
+	RolesMapping              string `json:"roles_mapping,omitempty"`
+	JITEnabled                bool   `json:"jit_enabled"`
+	RestrictedDomain          string `json:"restricted_domain,omitempty"`
+	TriggerLoginAutomatically bool   `json:"trigger_login_automatically"`
+	DisableEmailPasswordLogin bool   `json:"disable_email_password_login"`
+}
+
+// ConfigType returns SSOConfigOIDC.
+func (c OIDCConfig) ConfigType() string { return SSOConfigOIDC }
+
+// Validate ensures that the issuer is an absolute URL and that the client ID is set.
+func (c OIDCConfig) Validate() error {
+	if c.Issuer == "" {
+		return errors.New("issuer is required")
+	}
+
+	issuer, err := url.Parse(c.Issuer)
+	if err != nil || issuer.Scheme == "" || issuer.Host == "" {
+		return fmt.Errorf("invalid issuer: %s", c.Issuer)
+	}
+
+	if c.ClientID == "" {
+		return errors.New("client id is required")
+	}
+
+	return nil
+}
+
+// String formats the configuration with the client secret redacted.
+func (c OIDCConfig) String() string {
+	return fmt.Sprintf("OIDCConfig{Issuer:%s ClientID:%s ClientSecret:%s Scopes:%s AuthURL:%s TokenURL:%s UserInfoURL:%s "+
+		"Audience:%s JWTEmailKey:%s JWTRolesKey:%s JWTFirstNameKey:%s JWTLastNameKey:%s RolesMapping:%s JITEnabled:%t "+
+		"RestrictedDomain:%s TriggerLoginAutomatically:%t DisableEmailPasswordLogin:%t}",
+		c.Issuer, c.ClientID, secret(c.ClientSecret), c.Scopes, c.AuthURL, c.TokenURL, c.UserInfoURL,
+		c.Audience, c.JWTEmailKey, c.JWTRolesKey, c.JWTFirstNameKey, c.JWTLastNameKey, c.RolesMapping, c.JITEnabled,
+		c.RestrictedDomain, c.TriggerLoginAutomatically, c.DisableEmailPasswordLogin)
+}
+
+// GoString formats the configuration for %#v with the client secret redacted.
+func (c OIDCConfig) GoString() string { return c.String() }
+
+// SAMLConfig configures single sign-on with a SAML identity provider.
+type SAMLConfig struct {
+	// IdPMetadataXML is the metadata document published by the identity provider.
+	IdPMetadataXML            string `json:"saml_idp_metadata_xml"`
+	FirstNameAttribute        string `json:"saml_first_name_attribute,omitempty"`
+	LastNameAttribute         string `json:"saml_last_name_attribute,omitempty"`
+	GroupsAttribute           string `json:"saml_groups_attribute,omitempty"`
+	SyncGroupClaims           bool   `json:"saml_sync_group_claims"`
+	JITEnabled                bool   `json:"jit_enabled"`
+	RestrictedDomain          string `json:"restricted_domain,omitempty"`
+	TriggerLoginAutomatically bool   `json:"trigger_login_automatically"`
+	DisableEmailPasswordLogin bool   `json:"disable_email_password_login"`
+}
+
+// ConfigType returns SSOConfigSAML.
+func (c SAMLConfig) ConfigType() string { return SSOConfigSAML }
+
+// Validate ensures that the identity provider metadata is set and is a well-formed XML document whose root element
+// is an EntityDescriptor or EntitiesDescriptor.
+func (c SAMLConfig) Validate() error {
+	if strings.TrimSpace(c.IdPMetadataXML) == "" {
+		return errors.New("metadata xml is required")
+	}
+
+	var root string
+	decoder := xml.NewDecoder(strings.NewReader(c.IdPMetadataXML))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("invalid metadata xml: %w", err)
+		}
+
+		if start, ok := token.(xml.StartElement); ok && root == "" {
+			root = start.Name.Local
+		}
+	}
+
+	switch root {
+	case "":
+		return errors.New("invalid metadata xml: no root element")
+	case "EntityDescriptor", "EntitiesDescriptor":
+		return nil
+	default:
+		return fmt.Errorf("invalid metadata xml: unexpected root element %s", root)
+	}
+}
+
+// String formats the configuration. The metadata XML is public, so nothing is redacted, but it is abbreviated to
+// its length.
+func (c SAMLConfig) String() string {
+	return fmt.Sprintf("SAMLConfig{IdPMetadataXML:<%d bytes> FirstNameAttribute:%s LastNameAttribute:%s GroupsAttribute:%s "+
+		"SyncGroupClaims:%t JITEnabled:%t RestrictedDomain:%s TriggerLoginAutomatically:%t DisableEmailPasswordLogin:%t}",
+		len(c.IdPMetadataXML), c.FirstNameAttribute, c.LastNameAttribute, c.GroupsAttribute,
+		c.SyncGroupClaims, c.JITEnabled, c.RestrictedDomain, c.TriggerLoginAutomatically, c.DisableEmailPasswordLogin)
+}
+
+// GoString formats the configuration for %#v.
+func (c SAMLConfig) GoString() string { return c.String() }
+
+// GoogleSSOConfig configures single sign-on with Google.
+type GoogleSSOConfig struct {
+	ClientID                  string `json:"google_client_id"`
+	ClientSecret              string `json:"google_client_secret,omitempty"`
+	JITEnabled                bool   `json:"jit_enabled"`
+	RestrictedDomain          string `json:"restricted_domain,omitempty"`
+	TriggerLoginAutomatically bool   `json:"trigger_login_automatically"`
+	DisableEmailPasswordLogin bool   `json:"disable_email_password_login"`
+}
+
+// ConfigType returns SSOConfigGoogle.
+func (c GoogleSSOConfig) ConfigType() string { return SSOConfigGoogle }
+
+// Validate ensures that the client ID is set.
+func (c GoogleSSOConfig) Validate() error {
+	if c.ClientID == "" {
+		return errors.New("client id is required")
+	}
+
+	return nil
+}
+
+// String formats the configuration with the client secret redacted.
+func (c GoogleSSOConfig) String() string {
+	return fmt.Sprintf("GoogleSSOConfig{ClientID:%s ClientSecret:%s JITEnabled:%t RestrictedDomain:%s "+
+		"TriggerLoginAutomatically:%t DisableEmailPasswordLogin:%t}",
+		c.ClientID, secret(c.ClientSecret), c.JITEnabled, c.RestrictedDomain,
+		c.TriggerLoginAutomatically, c.DisableEmailPasswordLogin)
+}
+
+// GoString formats the configuration for %#v with the client secret redacted.
+func (c GoogleSSOConfig) GoString() string { return c.String() }
+
+// decodeSSOConfig decodes an SSO configuration into the typed configuration named by its config_type field. A missing
+// or null configuration decodes to nil.
+func decodeSSOConfig(data json.RawMessage) (SSOConfig, error) {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) == 0 || string(trimmed) == "null" {
+		return nil, nil
+	}
+
+	var header struct {
+		ConfigType string `json:"config_type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("decoding sso config: %w", err)
+	}
+
+	switch header.ConfigType {
+	case SSOConfigOIDC:
+		return decodeTypedSSOConfig[OIDCConfig](header.ConfigType, data)
+	case SSOConfigSAML:
+		return decodeTypedSSOConfig[SAMLConfig](header.ConfigType, data)
+	case SSOConfigGoogle:
+		return decodeTypedSSOConfig[GoogleSSOConfig](header.ConfigType, data)
+	default:
+		return nil, fmt.Errorf("unsupported sso config type: %s", header.ConfigType)
+	}
+}
+
+// decodeTypedSSOConfig decodes an SSO configuration into the configuration struct T.
+func decodeTypedSSOConfig[T SSOConfig](configType string, data json.RawMessage) (SSOConfig, error) {
+	var config T
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("decoding %s sso config: %w", configType, err)
+	}
+
+	return config, nil
+}
+
+// encodeSSOConfig encodes the configuration with its config_type field as the data of the request body.
+func encodeSSOConfig(config SSOConfig) ([]byte, error) {
+	fields, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(fields, &data); err != nil {
+		return nil, err
+	}
+
+	data["config_type"], err = json.Marshal(config.ConfigType())
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}{
+		Data: data,
+	})
+}
+
+// GetSSOConfig returns the SSO configuration of the organization as an OIDCConfig, SAMLConfig or GoogleSSOConfig
+// value, or nil when SSO is not configured. The API token must have the "SSO > Read" scope.
+func (c *Client) GetSSOConfig(ctx context.Context) (SSOConfig, error) {
+	baseURL := fmt.Sprintf("%s/sso/config", c.BaseURL)
+	data, err := doSingleRequest[json.RawMessage](ctx, c, "GET", baseURL, nil)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	return decodeSSOConfig(*data)
+}
+
+// SetSSOConfig replaces the SSO configuration of the organization and returns the saved configuration. The
+// configuration is validated before it is sent. The API token must have the "SSO > Write" scope.
+func (c *Client) SetSSOConfig(ctx context.Context, config SSOConfig) (SSOConfig, error) {
+	if config == nil {
+		return nil, errors.New("sso config cannot be nil")
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("validating %s sso config: %w", config.ConfigType(), err)
+	}
+
+	requestBodyJSON, err := encodeSSOConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	baseURL := fmt.Sprintf("%s/sso/config", c.BaseURL)
+	data, err := doSingleRequest[json.RawMessage](ctx, c, "POST", baseURL, requestBodyJSON)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	return decodeSSOConfig(*data)
+}
+
+// DeleteSSOConfig removes the SSO configuration of the organization, so users sign in with their email and password.
+// The API token must have the "SSO > Write" scope.
+func (c *Client) DeleteSSOConfig(ctx context.Context) error {
+	baseURL := fmt.Sprintf("%s/sso/config", c.BaseURL)
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
+	return err
+}
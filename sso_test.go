@@ -0,0 +1,166 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const samlMetadata = `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com"></EntityDescriptor>`
+
+func TestSSOConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config retool.SSOConfig
+		err    string
+	}{
+		{"valid oidc", retool.OIDCConfig{Issuer: "https://idp.example.com", ClientID: "retool"}, ""},
+		{"missing issuer", retool.OIDCConfig{ClientID: "retool"}, "issuer is required"},
+		{"relative issuer", retool.OIDCConfig{Issuer: "idp.example.com", ClientID: "retool"}, "invalid issuer: idp.example.com"},
+		{"missing oidc client id", retool.OIDCConfig{Issuer: "https://idp.example.com"}, "client id is required"},
+		{"valid saml", retool.SAMLConfig{IdPMetadataXML: samlMetadata}, ""},
+		{"missing metadata", retool.SAMLConfig{IdPMetadataXML: " "}, "metadata xml is required"},
+		{"malformed metadata", retool.SAMLConfig{IdPMetadataXML: "<EntityDescriptor>"}, "invalid metadata xml: XML syntax error on line 1: unexpected EOF"},
+		{"plain text metadata", retool.SAMLConfig{IdPMetadataXML: "not xml"}, "invalid metadata xml: no root element"},
+		{"unexpected root element", retool.SAMLConfig{IdPMetadataXML: "<html></html>"}, "invalid metadata xml: unexpected root element html"},
+		{"missing google client id", retool.GoogleSSOConfig{}, "client id is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestSSOConfig_StringRedactsSecrets(t *testing.T) {
+	configs := []retool.SSOConfig{
+		retool.OIDCConfig{Issuer: "https://idp.example.com", ClientID: "retool", ClientSecret: "oidc-secret"},
+		&retool.GoogleSSOConfig{ClientID: "retool.apps.googleusercontent.com", ClientSecret: "google-secret"},
+	}
+
+	for _, config := range configs {
+		t.Run(config.ConfigType(), func(t *testing.T) {
+			output := fmt.Sprintf("%s %v %+v %#v", config, config, config, config)
+			assert.NotContains(t, output, "secret")
+			assert.Contains(t, output, "[REDACTED]")
+		})
+	}
+}
+
+func TestGetSSOConfig_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/sso/config", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"config_type": "saml", "saml_idp_metadata_xml": "<EntityDescriptor/>", "saml_groups_attribute": "groups", "jit_enabled": true}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	config, err := client.GetSSOConfig(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, retool.SAMLConfig{IdPMetadataXML: "<EntityDescriptor/>", GroupsAttribute: "groups", JITEnabled: true}, config)
+}
+
+func TestGetSSOConfig_NotConfigured(t *testing.T) {
+	responses := []string{
+		`{"success": true, "data": null}`,
+		`{"success": true}`,
+	}
+
+	for _, response := range responses {
+		t.Run(response, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintln(w, response)
+			}))
+			defer server.Close()
+
+			client, err := retool.NewClient("test-api-key", server.URL)
+			assert.NoError(t, err)
+
+			config, err := client.GetSSOConfig(context.Background())
+			assert.NoError(t, err)
+			assert.Nil(t, config)
+		})
+	}
+}
+
+func TestGetSSOConfig_UnsupportedType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"config_type": "google & oidc"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	_, err = client.GetSSOConfig(context.Background())
+	assert.EqualError(t, err, "unsupported sso config type: google & oidc")
+}
+
+func TestSetSSOConfig_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/sso/config", r.URL.Path)
+
+		var body struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "oidc", body.Data["config_type"])
+		assert.Equal(t, "https://idp.example.com", body.Data["oidc_issuer"])
+		assert.Equal(t, "oidc-secret", body.Data["oidc_client_secret"])
+
+		fmt.Fprintln(w, `{"success": true, "data": {"config_type": "oidc", "oidc_issuer": "https://idp.example.com", "oidc_client_id": "retool"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	config, err := client.SetSSOConfig(context.Background(), retool.OIDCConfig{
+		Issuer:       "https://idp.example.com",
+		ClientID:     "retool",
+		ClientSecret: "oidc-secret",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, retool.OIDCConfig{Issuer: "https://idp.example.com", ClientID: "retool"}, config)
+}
+
+func TestSetSSOConfig_ValidationFailure(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://example.com")
+	assert.NoError(t, err)
+
+	_, err = client.SetSSOConfig(context.Background(), retool.SAMLConfig{})
+	assert.EqualError(t, err, "validating saml sso config: metadata xml is required")
+
+	_, err = client.SetSSOConfig(context.Background(), nil)
+	assert.EqualError(t, err, "sso config cannot be nil")
+}
+
+func TestDeleteSSOConfig_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api/v2/sso/config", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.DeleteSSOConfig(context.Background()))
+}
@@ -0,0 +1,248 @@
+package retoolsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"regexp"
+)
+
+var (
+	// hexColorPattern matches hex colors such as "#3C92DC", with an optional alpha channel.
+	hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	// borderRadiusPattern matches border radii in pixels such as "4px".
+	borderRadiusPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?px$`)
+)
+
+// ThemeColors is the palette of a theme. Every color is a hex color such as "#3C92DC"; unset colors use the Retool
+// defaults.
+type ThemeColors struct {
+	Primary   string `json:"primary,omitempty"`
+	Secondary string `json:"secondary,omitempty"`
+	Tertiary  string `json:"tertiary,omitempty"`
+	Success   string `json:"success,omitempty"`
+	Warning   string `json:"warning,omitempty"`
+	Danger    string `json:"danger,omitempty"`
+	Info      string `json:"info,omitempty"`
+	Highlight string `json:"highlight,omitempty"`
+	Canvas    string `json:"canvas,omitempty"`
+	Surface   string `json:"surface,omitempty"`
+	Border    string `json:"border,omitempty"`
+	TextDark  string `json:"text_dark,omitempty"`
+	TextLight string `json:"text_light,omitempty"`
+}
+
+// validate ensures that the set colors are hex colors.
+func (c ThemeColors) validate() error {
+	colors := []struct {
+		name  string
+		value string
+	}{
+		{"primary", c.Primary},
+		{"secondary", c.Secondary},
+		{"tertiary", c.Tertiary},
+		{"success", c.Success},
+		{"warning", c.Warning},
+		{"danger", c.Danger},
+		{"info", c.Info},
+		{"highlight", c.Highlight},
+		{"canvas", c.Canvas},
+		{"surface", c.Surface},
+		{"border", c.Border},
+		{"text_dark", c.TextDark},
+		{"text_light", c.TextLight},
+	}
+
+	for _, color := range colors {
+		if color.value != "" && !hexColorPattern.MatchString(color.value) {
+			return fmt.Errorf("invalid %s color: %s", color.name, color.value)
+		}
+	}
+
+	return nil
+}
+
+// ThemeFonts is the typography of a theme. Fonts are font family names such as "Inter"; unset fonts use the Retool
+// defaults.
+type ThemeFonts struct {
+	Default   string `json:"default,omitempty"`
+	Heading   string `json:"heading,omitempty"`
+	Monospace string `json:"monospace,omitempty"`
+}
+
+// Theme is a struct that contains the information about an app theme.
+type Theme struct {
+	ID     string      `json:"id,omitempty"`
+	Name   string      `json:"name"`
+	Colors ThemeColors `json:"colors"`
+	Fonts  ThemeFonts  `json:"fonts"`
+	// BorderRadius is the corner radius of components in pixels, such as "4px".
+	BorderRadius string `json:"border_radius,omitempty"`
+	// Default reports whether the theme is applied to apps without a theme of their own. It is set with
+	// SetDefaultTheme.
+	Default   bool   `json:"default,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// Validate ensures that the name is set and that the colors and border radius are valid.
+func (t *Theme) Validate() error {
+	if t.Name == "" {
+		return errors.New("theme name is required")
+	}
+
+	if err := t.Colors.validate(); err != nil {
+		return err
+	}
+
+	if t.BorderRadius != "" && !borderRadiusPattern.MatchString(t.BorderRadius) {
+		return fmt.Errorf("invalid border radius: %s", t.BorderRadius)
+	}
+
+	return nil
+}
+
+// themeRequest returns the request body for creating or updating the theme, leaving out the fields set by the server.
+func themeRequest(theme *Theme) ([]byte, error) {
+	requestBody := struct {
+		Name         string      `json:"name"`
+		Colors       ThemeColors `json:"colors"`
+		Fonts        ThemeFonts  `json:"fonts"`
+		BorderRadius string      `json:"border_radius,omitempty"`
+	}{
+		Name:         theme.Name,
+		Colors:       theme.Colors,
+		Fonts:        theme.Fonts,
+		BorderRadius: theme.BorderRadius,
+	}
+
+	return json.Marshal(requestBody)
+}
+
+// GetTheme returns the theme with the given ID. The API token must have the "Themes > Read" scope.
+func (c *Client) GetTheme(ctx context.Context, id string) (*Theme, error) {
+	baseURL := fmt.Sprintf("%s/themes/%s", c.BaseURL, id)
+	return doSingleRequest[Theme](ctx, c, "GET", baseURL, nil)
+}
+
+// ListThemes returns a list of the themes of the space. The API token must have the "Themes > Read" scope.
+func (c *Client) ListThemes(ctx context.Context) ([]Theme, error) {
+	baseURL := fmt.Sprintf("%s/themes", c.BaseURL)
+	return doPaginatedRequest[Theme](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllThemes returns an iterator over all themes, fetching pages lazily as the iteration progresses.
+// The API token must have the "Themes > Read" scope.
+func (c *Client) AllThemes(ctx context.Context) iter.Seq2[Theme, error] {
+	return paginateItems(c.ThemePages(ctx, ""))
+}
+
+// ThemePages returns an iterator over the pages of themes, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Themes > Read" scope.
+func (c *Client) ThemePages(ctx context.Context, next string) iter.Seq2[*Page[Theme], error] {
+	baseURL := fmt.Sprintf("%s/themes", c.BaseURL)
+	return paginatePages[Theme](ctx, c, "GET", baseURL, nil, url.Values{}, next)
+}
+
+// CreateTheme creates a theme and returns it. The theme is validated before it is sent; its ID, Default and
+// timestamps are ignored. The API token must have the "Themes > Write" scope.
+func (c *Client) CreateTheme(ctx context.Context, theme *Theme) (*Theme, error) {
+	if theme == nil {
+		return nil, errors.New("theme cannot be nil")
+	}
+
+	if err := theme.Validate(); err != nil {
+		return nil, fmt.Errorf("validating theme: %w", err)
+	}
+
+	requestBodyJSON, err := themeRequest(theme)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	baseURL := fmt.Sprintf("%s/themes", c.BaseURL)
+	return doSingleRequest[Theme](ctx, c, "POST", baseURL, requestBodyJSON)
+}
+
+// UpdateTheme replaces the name, colors, fonts and border radius of the theme and returns the updated theme. The
+// theme is validated before it is sent. The API token must have the "Themes > Write" scope.
+func (c *Client) UpdateTheme(ctx context.Context, id string, theme *Theme) (*Theme, error) {
+	if theme == nil {
+		return nil, errors.New("theme cannot be nil")
+	}
+
+	if err := theme.Validate(); err != nil {
+		return nil, fmt.Errorf("validating theme: %w", err)
+	}
+
+	requestBodyJSON, err := themeRequest(theme)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	baseURL := fmt.Sprintf("%s/themes/%s", c.BaseURL, id)
+	return doSingleRequest[Theme](ctx, c, "PUT", baseURL, requestBodyJSON)
+}
+
+// DeleteTheme deletes the theme with the given ID. The API token must have the "Themes > Write" scope.
+func (c *Client) DeleteTheme(ctx context.Context, id string) error {
+	baseURL := fmt.Sprintf("%s/themes/%s", c.BaseURL, id)
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
+	return err
+}
+
+// SetDefaultTheme makes the theme the default of the space, applied to apps without a theme of their own, and returns
+// it. The API token must have the "Themes > Write" scope.
+func (c *Client) SetDefaultTheme(ctx context.Context, id string) (*Theme, error) {
+	baseURL := fmt.Sprintf("%s/themes/%s/default", c.BaseURL, id)
+	return doSingleRequest[Theme](ctx, c, "POST", baseURL, nil)
+}
+
+// CopyThemeOpts is a struct that contains optional parameters for CopyThemeToSpace.
+type CopyThemeOpts struct {
+	// SetDefault makes the copy the default theme of the child space.
+	SetDefault bool
+}
+
+// CopyThemeToSpace copies the theme with the given ID into a child space and returns the copy. API tokens are scoped
+// to one space, so child is a client of the child space, such as a Client created with the domain of a space
+// returned by CreateSpace and an API token of that space. The API token of c must have the "Themes > Read" scope and
+// the one of child the "Themes > Write" scope.
+func (c *Client) CopyThemeToSpace(ctx context.Context, id string, child ThemesAPI, opts *CopyThemeOpts) (*Theme, error) {
+	if child == nil {
+		return nil, errors.New("child space client cannot be nil")
+	}
+
+	theme, err := c.GetTheme(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting theme %s: %w", id, err)
+	}
+	if theme == nil {
+		return nil, fmt.Errorf("getting theme %s: empty response", id)
+	}
+
+	theme, err = child.CreateTheme(ctx, theme)
+	if err != nil {
+		return nil, fmt.Errorf("creating theme in child space: %w", err)
+	}
+	if theme == nil {
+		return nil, errors.New("creating theme in child space: empty response")
+	}
+
+	if opts != nil && opts.SetDefault {
+		defaultTheme, err := child.SetDefaultTheme(ctx, theme.ID)
+		if err != nil {
+			return nil, fmt.Errorf("setting default theme in child space: %w", err)
+		}
+		if defaultTheme == nil {
+			theme.Default = true
+			return theme, nil
+		}
+		theme = defaultTheme
+	}
+
+	return theme, nil
+}
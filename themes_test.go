@@ -0,0 +1,171 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTheme_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/themes", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "Brand", "colors": {"primary": "#3C92DC", "canvas": "#fff"}, "fonts": {"default": "Inter"}, "border_radius": "4px"}`, string(body))
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "theme_1", "name": "Brand", "colors": {"primary": "#3C92DC", "canvas": "#fff"}, "fonts": {"default": "Inter"}, "border_radius": "4px"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	theme, err := client.CreateTheme(context.Background(), &retool.Theme{
+		ID:           "ignored",
+		Name:         "Brand",
+		Colors:       retool.ThemeColors{Primary: "#3C92DC", Canvas: "#fff"},
+		Fonts:        retool.ThemeFonts{Default: "Inter"},
+		BorderRadius: "4px",
+		Default:      true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "theme_1", theme.ID)
+	assert.Equal(t, "#3C92DC", theme.Colors.Primary)
+	assert.Equal(t, "Inter", theme.Fonts.Default)
+}
+
+func TestTheme_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		theme retool.Theme
+		err   string
+	}{
+		{"valid", retool.Theme{Name: "Brand", Colors: retool.ThemeColors{Primary: "#3C92DCFF"}, BorderRadius: "2.5px"}, ""},
+		{"missing name", retool.Theme{}, "theme name is required"},
+		{"invalid color", retool.Theme{Name: "Brand", Colors: retool.ThemeColors{Danger: "red"}}, "invalid danger color: red"},
+		{"invalid radius", retool.Theme{Name: "Brand", BorderRadius: "4"}, "invalid border radius: 4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.theme.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestUpdateTheme_Invalid(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://retool.example.com")
+	assert.NoError(t, err)
+
+	_, err = client.UpdateTheme(context.Background(), "theme_1", nil)
+	assert.EqualError(t, err, "theme cannot be nil")
+
+	_, err = client.UpdateTheme(context.Background(), "theme_1", &retool.Theme{Name: "Brand", Colors: retool.ThemeColors{Primary: "blue"}})
+	assert.EqualError(t, err, "validating theme: invalid primary color: blue")
+}
+
+func TestSetDefaultTheme(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/themes/theme_1/default", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "theme_1", "name": "Brand", "default": true}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	theme, err := client.SetDefaultTheme(context.Background(), "theme_1")
+	assert.NoError(t, err)
+	assert.True(t, theme.Default)
+}
+
+func TestCopyThemeToSpace(t *testing.T) {
+	parent := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/api/v2/themes/theme_1", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "theme_1", "name": "Brand", "colors": {"primary": "#3C92DC"}, "fonts": {"heading": "Inter"}, "border_radius": "8px", "default": true}}`)
+	}))
+	defer parent.Close()
+
+	var requests []string
+	child := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		assert.Equal(t, "Bearer child-api-key", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/api/v2/themes":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"name": "Brand", "colors": {"primary": "#3C92DC"}, "fonts": {"heading": "Inter"}, "border_radius": "8px"}`, string(body))
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "theme_9", "name": "Brand"}}`)
+		case "/api/v2/themes/theme_9/default":
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "theme_9", "name": "Brand", "default": true}}`)
+		}
+	}))
+	defer child.Close()
+
+	parentClient, err := retool.NewClient("parent-api-key", parent.URL)
+	assert.NoError(t, err)
+	childClient, err := retool.NewClient("child-api-key", child.URL)
+	assert.NoError(t, err)
+
+	theme, err := parentClient.CopyThemeToSpace(context.Background(), "theme_1", childClient, &retool.CopyThemeOpts{SetDefault: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "theme_9", theme.ID)
+	assert.True(t, theme.Default)
+	assert.Equal(t, []string{"POST /api/v2/themes", "POST /api/v2/themes/theme_9/default"}, requests)
+}
+
+func TestCopyThemeToSpace_SetDefaultNoContent(t *testing.T) {
+	parent := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "theme_1", "name": "Brand"}}`)
+	}))
+	defer parent.Close()
+
+	child := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/themes/theme_9/default" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "theme_9", "name": "Brand"}}`)
+	}))
+	defer child.Close()
+
+	parentClient, err := retool.NewClient("parent-api-key", parent.URL)
+	assert.NoError(t, err)
+	childClient, err := retool.NewClient("child-api-key", child.URL)
+	assert.NoError(t, err)
+
+	theme, err := parentClient.CopyThemeToSpace(context.Background(), "theme_1", childClient, &retool.CopyThemeOpts{SetDefault: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "theme_9", theme.ID)
+	assert.True(t, theme.Default)
+}
+
+func TestCopyThemeToSpace_ParentError(t *testing.T) {
+	parent := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"success": false, "message": "theme not found"}`)
+	}))
+	defer parent.Close()
+
+	parentClient, err := retool.NewClient("parent-api-key", parent.URL)
+	assert.NoError(t, err)
+	childClient, err := retool.NewClient("child-api-key", "https://child.retool.example.com")
+	assert.NoError(t, err)
+
+	_, err = parentClient.CopyThemeToSpace(context.Background(), "theme_1", childClient, nil)
+	assert.ErrorContains(t, err, "getting theme theme_1")
+	assert.ErrorContains(t, err, "theme not found")
+}
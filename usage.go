@@ -0,0 +1,281 @@
+package retoolsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Usage intervals, the granularity of the usage time series.
+const (
+	UsageIntervalDay   = "day"
+	UsageIntervalWeek  = "week"
+	UsageIntervalMonth = "month"
+)
+
+// usageDateFormat is the format of the dates of the usage endpoints.
+const usageDateFormat = "2006-01-02"
+
+// UsageOpts is a struct that contains optional query parameters for the usage methods.
+type UsageOpts struct {
+	// Interval is the granularity of the time series, UsageIntervalDay (the default), UsageIntervalWeek or
+	// UsageIntervalMonth.
+	Interval string
+}
+
+// usageQuery validates the time range and returns the query parameters of a usage request. The dates are those of
+// start and end in UTC, so the same instants give the same query on every host.
+func usageQuery(start, end time.Time, opts *UsageOpts) (url.Values, error) {
+	if start.IsZero() || end.IsZero() {
+		return nil, errors.New("start and end are required")
+	}
+	if !start.Before(end) {
+		return nil, fmt.Errorf("start %s is not before end %s", start.UTC().Format(usageDateFormat), end.UTC().Format(usageDateFormat))
+	}
+
+	query := make(url.Values)
+	query.Add("start_date", start.UTC().Format(usageDateFormat))
+	query.Add("end_date", end.UTC().Format(usageDateFormat))
+
+	if opts != nil && opts.Interval != "" {
+		switch opts.Interval {
+		case UsageIntervalDay, UsageIntervalWeek, UsageIntervalMonth:
+			query.Add("interval", opts.Interval)
+		default:
+			return nil, fmt.Errorf("invalid usage interval: %s", opts.Interval)
+		}
+	}
+
+	return query, nil
+}
+
+// UsagePoint is the usage during one interval of a usage time series.
+type UsagePoint struct {
+	// Date is the first day of the interval, in the format "2006-01-02".
+	Date         string `json:"date"`
+	ActiveUsers  int    `json:"active_users"`
+	AppViews     int    `json:"app_views"`
+	QueryRuns    int    `json:"query_runs"`
+	WorkflowRuns int    `json:"workflow_runs"`
+}
+
+// Time returns the Date of the point as a time in UTC.
+func (p UsagePoint) Time() (time.Time, error) {
+	return time.Parse(usageDateFormat, p.Date)
+}
+
+// Usage is the usage of the organization over a time range.
+type Usage struct {
+	StartDate string       `json:"start_date"`
+	EndDate   string       `json:"end_date"`
+	Interval  string       `json:"interval"`
+	Series    []UsagePoint `json:"series"`
+}
+
+// AppUsage is the usage of an app over a time range.
+type AppUsage struct {
+	AppID    string       `json:"app_id"`
+	AppName  string       `json:"app_name"`
+	Viewers  int          `json:"viewers"`
+	AppViews int          `json:"app_views"`
+	Series   []UsagePoint `json:"series"`
+}
+
+// UserUsage is the usage of a user over a time range.
+type UserUsage struct {
+	UserID     string       `json:"user_id"`
+	Email      string       `json:"email"`
+	LastActive string       `json:"last_active,omitempty"`
+	AppViews   int          `json:"app_views"`
+	QueryRuns  int          `json:"query_runs"`
+	Series     []UsagePoint `json:"series"`
+}
+
+// Active reports whether the user used Retool during the time range.
+func (u *UserUsage) Active() bool {
+	return u.AppViews > 0 || u.QueryRuns > 0
+}
+
+// GetUsage returns the usage of the organization between start and end as a time series.
+// The API token must have the "Usage > Read" scope.
+func (c *Client) GetUsage(ctx context.Context, start, end time.Time, opts *UsageOpts) (*Usage, error) {
+	query, err := usageQuery(start, end, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/usage?%s", c.BaseURL, query.Encode())
+	return doSingleRequest[Usage](ctx, c, "GET", baseURL, nil)
+}
+
+// ListAppUsage returns the usage of every app between start and end. The API token must have the "Usage > Read" scope.
+func (c *Client) ListAppUsage(ctx context.Context, start, end time.Time, opts *UsageOpts) ([]AppUsage, error) {
+	query, err := usageQuery(start, end, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/usage/apps", c.BaseURL)
+	return doPaginatedRequest[AppUsage](ctx, c, "GET", baseURL, nil, query)
+}
+
+// AllAppUsage returns an iterator over the usage of every app between start and end, fetching pages lazily as the
+// iteration progresses. The API token must have the "Usage > Read" scope.
+func (c *Client) AllAppUsage(ctx context.Context, start, end time.Time, opts *UsageOpts) iter.Seq2[AppUsage, error] {
+	return paginateItems(c.AppUsagePages(ctx, start, end, opts, ""))
+}
+
+// AppUsagePages returns an iterator over the pages of app usage between start and end, starting at the page
+// identified by next (or the first page when empty). The API token must have the "Usage > Read" scope.
+func (c *Client) AppUsagePages(ctx context.Context, start, end time.Time, opts *UsageOpts, next string) iter.Seq2[*Page[AppUsage], error] {
+	query, err := usageQuery(start, end, opts)
+	if err != nil {
+		return failedPages[AppUsage](err)
+	}
+
+	baseURL := fmt.Sprintf("%s/usage/apps", c.BaseURL)
+	return paginatePages[AppUsage](ctx, c, "GET", baseURL, nil, query, next)
+}
+
+// ListUserUsage returns the usage of every user between start and end. Users without activity in the time range are
+// not included. The API token must have the "Usage > Read" scope.
+func (c *Client) ListUserUsage(ctx context.Context, start, end time.Time, opts *UsageOpts) ([]UserUsage, error) {
+	query, err := usageQuery(start, end, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := fmt.Sprintf("%s/usage/users", c.BaseURL)
+	return doPaginatedRequest[UserUsage](ctx, c, "GET", baseURL, nil, query)
+}
+
+// AllUserUsage returns an iterator over the usage of every user between start and end, fetching pages lazily as the
+// iteration progresses. The API token must have the "Usage > Read" scope.
+func (c *Client) AllUserUsage(ctx context.Context, start, end time.Time, opts *UsageOpts) iter.Seq2[UserUsage, error] {
+	return paginateItems(c.UserUsagePages(ctx, start, end, opts, ""))
+}
+
+// UserUsagePages returns an iterator over the pages of user usage between start and end, starting at the page
+// identified by next (or the first page when empty). The API token must have the "Usage > Read" scope.
+func (c *Client) UserUsagePages(ctx context.Context, start, end time.Time, opts *UsageOpts, next string) iter.Seq2[*Page[UserUsage], error] {
+	query, err := usageQuery(start, end, opts)
+	if err != nil {
+		return failedPages[UserUsage](err)
+	}
+
+	baseURL := fmt.Sprintf("%s/usage/users", c.BaseURL)
+	return paginatePages[UserUsage](ctx, c, "GET", baseURL, nil, query, next)
+}
+
+// failedPages returns a page iterator that yields err without fetching any page.
+func failedPages[T any](err error) iter.Seq2[*Page[T], error] {
+	return func(yield func(*Page[T], error) bool) {
+		yield(nil, err)
+	}
+}
+
+// UserUtilization is a user together with their usage over a time range.
+type UserUtilization struct {
+	User User
+	// Usage is nil when the user has no recorded usage in the time range.
+	Usage *UserUsage
+}
+
+// Active reports whether the user used Retool during the time range.
+func (u UserUtilization) Active() bool {
+	return u.Usage != nil && u.Usage.Active()
+}
+
+// SeatUtilization is the utilization of the seats of one user type over a time range.
+type SeatUtilization struct {
+	UserType string
+	// Seats is the number of active (not disabled) users of the type.
+	Seats int
+	// ActiveSeats is the number of those users who used Retool during the time range.
+	ActiveSeats int
+	Users       []UserUtilization
+}
+
+// Utilization returns the share of the seats that were used, between 0 and 1.
+func (s SeatUtilization) Utilization() float64 {
+	if s.Seats == 0 {
+		return 0
+	}
+
+	return float64(s.ActiveSeats) / float64(s.Seats)
+}
+
+// JoinUserUsage joins the usage with the users, matching by user ID or else by email, and groups the active users
+// by user type. Disabled users are left out. The result is sorted by user type.
+func JoinUserUsage(users []User, usage []UserUsage) []SeatUtilization {
+	byID := make(map[string]*UserUsage, len(usage))
+	byEmail := make(map[string]*UserUsage, len(usage))
+	for i := range usage {
+		if usage[i].UserID != "" {
+			byID[usage[i].UserID] = &usage[i]
+		}
+		if usage[i].Email != "" {
+			byEmail[strings.ToLower(usage[i].Email)] = &usage[i]
+		}
+	}
+
+	seats := make(map[string]*SeatUtilization)
+	for _, user := range users {
+		if !user.Active {
+			continue
+		}
+
+		userType := user.UserType
+		if userType == "" {
+			userType = UserTypeDefault
+		}
+
+		seat, ok := seats[userType]
+		if !ok {
+			seat = &SeatUtilization{UserType: userType}
+			seats[userType] = seat
+		}
+
+		utilization := UserUtilization{User: user, Usage: byID[user.ID]}
+		if utilization.Usage == nil {
+			utilization.Usage = byEmail[strings.ToLower(user.Email)]
+		}
+
+		seat.Seats++
+		if utilization.Active() {
+			seat.ActiveSeats++
+		}
+		seat.Users = append(seat.Users, utilization)
+	}
+
+	result := make([]SeatUtilization, 0, len(seats))
+	for _, seat := range seats {
+		result = append(result, *seat)
+	}
+
+	slices.SortFunc(result, func(a, b SeatUtilization) int { return strings.Compare(a.UserType, b.UserType) })
+
+	return result
+}
+
+// GetSeatUtilization lists the users and their usage between start and end and joins them with JoinUserUsage, to
+// report how many of the seats of every user type are used. The API token must have the "Users > Read" and
+// "Usage > Read" scopes.
+func (c *Client) GetSeatUtilization(ctx context.Context, start, end time.Time) ([]SeatUtilization, error) {
+	usage, err := c.ListUserUsage(ctx, start, end, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing user usage: %w", err)
+	}
+
+	users, err := c.ListUsers(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing users: %w", err)
+	}
+
+	return JoinUserUsage(users, usage), nil
+}
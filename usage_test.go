@@ -0,0 +1,142 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	usageStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	usageEnd   = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestGetUsage_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/usage", r.URL.Path)
+		assert.Equal(t, "2024-01-01", r.URL.Query().Get("start_date"))
+		assert.Equal(t, "2024-02-01", r.URL.Query().Get("end_date"))
+		assert.Equal(t, "week", r.URL.Query().Get("interval"))
+		fmt.Fprintln(w, `{"success": true, "data": {"start_date": "2024-01-01", "end_date": "2024-02-01", "interval": "week", "series": [{"date": "2024-01-01", "active_users": 12, "app_views": 340, "query_runs": 5120}]}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	usage, err := client.GetUsage(context.Background(), usageStart, usageEnd, &retool.UsageOpts{Interval: retool.UsageIntervalWeek})
+	assert.NoError(t, err)
+	assert.Equal(t, []retool.UsagePoint{{Date: "2024-01-01", ActiveUsers: 12, AppViews: 340, QueryRuns: 5120}}, usage.Series)
+
+	date, err := usage.Series[0].Time()
+	assert.NoError(t, err)
+	assert.Equal(t, usageStart, date)
+}
+
+func TestGetUsage_DatesInUTC(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2023-12-31", r.URL.Query().Get("start_date"))
+		assert.Equal(t, "2024-01-31", r.URL.Query().Get("end_date"))
+		fmt.Fprintln(w, `{"success": true, "data": {"start_date": "2023-12-31", "end_date": "2024-01-31", "interval": "day", "series": []}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	_, err = client.GetUsage(context.Background(), usageStart.In(tokyo).Add(-time.Hour), usageEnd.In(tokyo).Add(-time.Hour), nil)
+	assert.NoError(t, err)
+}
+
+func TestGetUsage_InvalidRange(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://example.com")
+	assert.NoError(t, err)
+
+	_, err = client.GetUsage(context.Background(), usageEnd, usageStart, nil)
+	assert.EqualError(t, err, "start 2024-02-01 is not before end 2024-01-01")
+
+	_, err = client.GetUsage(context.Background(), time.Time{}, usageEnd, nil)
+	assert.EqualError(t, err, "start and end are required")
+
+	_, err = client.ListAppUsage(context.Background(), usageStart, usageEnd, &retool.UsageOpts{Interval: "hour"})
+	assert.EqualError(t, err, "invalid usage interval: hour")
+
+	for _, err := range client.AllUserUsage(context.Background(), usageEnd, usageStart, nil) {
+		assert.EqualError(t, err, "start 2024-02-01 is not before end 2024-01-01")
+	}
+}
+
+func TestListAppUsage_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/usage/apps", r.URL.Path)
+		assert.Equal(t, "2024-01-01", r.URL.Query().Get("start_date"))
+		fmt.Fprintln(w, `{"success": true, "data": [{"app_id": "app_1", "app_name": "Orders", "viewers": 8, "app_views": 120}], "total_count": 1, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	usage, err := client.ListAppUsage(context.Background(), usageStart, usageEnd, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []retool.AppUsage{{AppID: "app_1", AppName: "Orders", Viewers: 8, AppViews: 120}}, usage)
+}
+
+func TestJoinUserUsage(t *testing.T) {
+	users := []retool.User{
+		{ID: "user_1", Email: "jane@example.com", Active: true, UserType: retool.UserTypeDefault},
+		{ID: "user_2", Email: "john@example.com", Active: true},
+		{ID: "user_3", Email: "kiosk@example.com", Active: true, UserType: retool.UserTypeMobile},
+		{ID: "user_4", Email: "former@example.com", Active: false},
+	}
+	usage := []retool.UserUsage{
+		{UserID: "user_1", AppViews: 20},
+		{Email: "Kiosk@example.com", QueryRuns: 3},
+		{UserID: "user_4", AppViews: 1},
+	}
+
+	seats := retool.JoinUserUsage(users, usage)
+	assert.Len(t, seats, 2)
+
+	assert.Equal(t, retool.UserTypeDefault, seats[0].UserType)
+	assert.Equal(t, 2, seats[0].Seats)
+	assert.Equal(t, 1, seats[0].ActiveSeats)
+	assert.Equal(t, 0.5, seats[0].Utilization())
+	assert.True(t, seats[0].Users[0].Active())
+	assert.Nil(t, seats[0].Users[1].Usage)
+
+	assert.Equal(t, retool.UserTypeMobile, seats[1].UserType)
+	assert.Equal(t, 1, seats[1].ActiveSeats)
+}
+
+func TestGetSeatUtilization_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/usage/users":
+			fmt.Fprintln(w, `{"success": true, "data": [{"user_id": "user_1", "email": "jane@example.com", "app_views": 4}], "total_count": 1, "has_more": false}`)
+		case "/api/v2/users":
+			fmt.Fprintln(w, `{"success": true, "data": [{"id": "user_1", "email": "jane@example.com", "active": true, "user_type": "default"}, {"id": "user_2", "email": "embed@example.com", "active": true, "user_type": "embed"}], "total_count": 2, "has_more": false}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	seats, err := client.GetSeatUtilization(context.Background(), usageStart, usageEnd)
+	assert.NoError(t, err)
+	assert.Len(t, seats, 2)
+	assert.Equal(t, 1.0, seats[0].Utilization())
+	assert.Equal(t, retool.UserTypeEmbed, seats[1].UserType)
+	assert.Equal(t, 0, seats[1].ActiveSeats)
+}
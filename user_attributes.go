@@ -1,11 +1,10 @@
 package retoolsdk
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
-	"net/http"
+	"iter"
 	"net/url"
 )
 
@@ -26,31 +25,24 @@ type OrganizationAttribute struct {
 
 // UpdateUserAttributes Available from API version 2.1.0+ and onprem version 3.20.1+.
 // Adds or updates a user attribute, and returns the updated user metadata. The API token must have the "Users > Write" scope.
-func (c *Client) UpdateUserAttributes(id string, attributes []UserAttribute) (map[string]interface{}, error) {
+func (c *Client) UpdateUserAttributes(ctx context.Context, id string, attributes []UserAttribute) (map[string]interface{}, error) {
 	if len(attributes) == 0 {
 		return nil, errors.New("no attributes provided")
 	}
 
-	requestBody, err := json.Marshal(attributes)
-	if err != nil {
-		return nil, fmt.Errorf("marshalling request: %w", err)
+	if err := c.requireFeature(ctx, FeatureUserAttributes); err != nil {
+		return nil, err
 	}
 
-	req, _ := http.NewRequest("POST", fmt.Sprintf("%s/users/%s/user_attributes", c.BaseURL, id), bytes.NewBuffer(requestBody))
-
-	var response Response[map[string]interface{}]
-
-	resp, err := c.HTTPClient.Do(req)
+	baseURL := fmt.Sprintf("%s/users/%s/user_attributes", c.BaseURL, id)
+	resp, err := c.Do(ctx, "POST", baseURL, attributes)
 	if err != nil {
 		return nil, fmt.Errorf("making request: %w", err)
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("decoding response: %w", err)
-	}
-
-	if !response.Success {
-		return nil, errors.New(response.Message)
+	response, err := decodeResponse[map[string]interface{}](resp)
+	if err != nil {
+		return nil, err
 	}
 
 	metadata := make(map[string]interface{})
@@ -66,35 +58,42 @@ func (c *Client) UpdateUserAttributes(id string, attributes []UserAttribute) (ma
 
 // DeleteUserAttribute Available from API version 2.1.0+ and onprem version 3.20.1+.
 // Deletes a user attribute, and returns the updated user metadata. The API token must have the "Users > Write" scope.
-func (c *Client) DeleteUserAttribute(id, attribute string) (interface{}, error) {
-	req, _ := http.NewRequest("DELETE", fmt.Sprintf("%s/users/%s/user_attributes/%s", c.BaseURL, id, attribute), nil)
-
-	var response Response[User]
+func (c *Client) DeleteUserAttribute(ctx context.Context, id, attribute string) (interface{}, error) {
+	if err := c.requireFeature(ctx, FeatureUserAttributes); err != nil {
+		return nil, err
+	}
 
-	resp, err := c.HTTPClient.Do(req)
+	baseURL := fmt.Sprintf("%s/users/%s/user_attributes/%s", c.BaseURL, id, attribute)
+	resp, err := c.Do(ctx, "DELETE", baseURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("making request: %w", err)
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("decoding response: %w", err)
-	}
-
-	if !response.Success {
-		return nil, errors.New(response.Message)
-	}
-
-	if response.Data != (User{}) {
-		return response.Data.Metadata, nil
+	response, err := decodeResponse[User](resp)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, nil
+	return response.Data.Metadata, nil
 }
 
 // GetOrganizationAttributes gets the list of currently configured user attributes for the organization.
 // The API token must have the "Users > Read" scope.
-func (c *Client) GetOrganizationAttributes() ([]OrganizationAttribute, error) {
+func (c *Client) GetOrganizationAttributes(ctx context.Context) ([]OrganizationAttribute, error) {
 	baseURL := fmt.Sprintf("%s/user_attributes", c.BaseURL)
 
-	return doPaginatedRequest[OrganizationAttribute](c, "GET", baseURL, nil, url.Values{})
+	return doPaginatedRequest[OrganizationAttribute](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllOrganizationAttributes returns an iterator over all organization user attributes, fetching pages lazily as the iteration progresses.
+// The API token must have the "Users > Read" scope.
+func (c *Client) AllOrganizationAttributes(ctx context.Context) iter.Seq2[OrganizationAttribute, error] {
+	return paginateItems(c.OrganizationAttributePages(ctx, ""))
+}
+
+// OrganizationAttributePages returns an iterator over the pages of organization user attributes, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Users > Read" scope.
+func (c *Client) OrganizationAttributePages(ctx context.Context, next string) iter.Seq2[*Page[OrganizationAttribute], error] {
+	baseURL := fmt.Sprintf("%s/user_attributes", c.BaseURL)
+	return paginatePages[OrganizationAttribute](ctx, c, "GET", baseURL, nil, url.Values{}, next)
 }
@@ -2,6 +2,7 @@ package retoolsdk_test
 
 import (
 	"bytes"
+	"context"
 	"github.com/stretchr/testify/assert"
 	retool "github.com/thoughtgears/retoolsdk"
 	"io"
@@ -43,7 +44,7 @@ func TestUpdateUserAttributes_Success(t *testing.T) {
 		"attribute2": "value2",
 	}
 
-	metadata, err := client.UpdateUserAttributes("user_123", attributes)
+	metadata, err := client.UpdateUserAttributes(context.Background(), "user_123", attributes)
 	assert.NoError(t, err)
 	assert.NotNil(t, metadata)
 	assert.Equal(t, expectedMetadata, metadata)
@@ -72,7 +73,7 @@ func TestUserAttributes_Failure(t *testing.T) {
 		{Name: "attribute1", Value: "value1"},
 	}
 
-	metadata, err := client.UpdateUserAttributes("user_123", attributes)
+	metadata, err := client.UpdateUserAttributes(context.Background(), "user_123", attributes)
 	assert.Error(t, err)
 	assert.Nil(t, metadata)
 	assert.Equal(t, "Invalid attributes", err.Error())
@@ -106,7 +107,7 @@ func TestDeleteUserAttribute_Success(t *testing.T) {
 		"attribute1": "value1",
 	}
 
-	metadata, err := client.DeleteUserAttribute("user_123", "attribute2")
+	metadata, err := client.DeleteUserAttribute(context.Background(), "user_123", "attribute2")
 	assert.NoError(t, err)
 	assert.NotNil(t, metadata)
 	assert.Equal(t, expectedMetadata, metadata)
@@ -131,7 +132,7 @@ func TestDeleteUserAttribute_Failure(t *testing.T) {
 		},
 	}
 
-	metadata, err := client.DeleteUserAttribute("user_123", "attribute1")
+	metadata, err := client.DeleteUserAttribute(context.Background(), "user_123", "attribute1")
 	assert.Error(t, err)
 	assert.Nil(t, metadata)
 	assert.Equal(t, "Attribute not found", err.Error())
@@ -173,7 +174,7 @@ func TestGetOrganizationAttributes_Success(t *testing.T) {
 		},
 	}
 
-	attributes, err := client.GetOrganizationAttributes()
+	attributes, err := client.GetOrganizationAttributes(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, attributes)
 	assert.Equal(t, "attribute1", attributes[0].Name)
@@ -198,7 +199,7 @@ func TestGetOrganizationAttributes_Failure(t *testing.T) {
 		},
 	}
 
-	attributes, err := client.GetOrganizationAttributes()
+	attributes, err := client.GetOrganizationAttributes(context.Background())
 	assert.Error(t, err)
 	assert.Nil(t, attributes)
 	assert.Equal(t, "Failed to retrieve attributes", err.Error())
@@ -0,0 +1,126 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateUserInvite_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/user_invites", r.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"email":     "john@example.com",
+			"user_type": "mobile",
+			"group_ids": []interface{}{float64(1), float64(2)},
+			"metadata":  map[string]interface{}{"department": "support"},
+		}, body)
+
+		fmt.Fprintln(w, `{"success": true, "data": {"id": 42, "invited_email": "john@example.com", "user_type": "mobile", "invite_link": "https://retool.example.com/invite/abc"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	invite, err := client.CreateUserInvite(context.Background(), "john@example.com", &retool.CreateUserInviteOpts{
+		UserType: retool.UserTypeMobile,
+		GroupIDs: []int{1, 2},
+		Metadata: map[string]interface{}{"department": "support"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, invite.ID)
+	assert.Equal(t, "https://retool.example.com/invite/abc", invite.InviteLink)
+}
+
+func TestCreateUserInvite_DefaultUserType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"email": "john@example.com", "user_type": "default"}, body)
+
+		fmt.Fprintln(w, `{"success": true, "data": {"id": 42}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	_, err = client.CreateUserInvite(context.Background(), "john@example.com", nil)
+	assert.NoError(t, err)
+}
+
+func TestCreateUserInvite_ValidationFailure(t *testing.T) {
+	client, err := retool.NewClient("test-api-key", "https://example.com")
+	assert.NoError(t, err)
+
+	_, err = client.CreateUserInvite(context.Background(), "", nil)
+	assert.EqualError(t, err, "email cannot be empty")
+
+	_, err = client.CreateUserInvite(context.Background(), "john@example.com", &retool.CreateUserInviteOpts{UserType: "admin"})
+	assert.EqualError(t, err, "invalid value for UserType: admin")
+}
+
+func TestListUserInvites_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/user_invites", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": 1, "invited_email": "a@example.com"}, {"id": 2, "invited_email": "b@example.com"}], "total_count": 2, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	invites, err := client.ListUserInvites(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, invites, 2)
+}
+
+func TestGetUserInvite_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/user_invites/42", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"id": 42, "invited_email": "john@example.com", "expires_at": "2024-01-08T00:00:00Z"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	invite, err := client.GetUserInvite(context.Background(), "42")
+	assert.NoError(t, err)
+	assert.Equal(t, "john@example.com", invite.InvitedEmail)
+}
+
+func TestRevokeAndResendUserInvite(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "DELETE /api/v2/user_invites/42":
+			w.WriteHeader(http.StatusNoContent)
+		case "POST /api/v2/user_invites/43/resend":
+			fmt.Fprintln(w, `{"success": true, "data": {"id": 43, "expires_at": "2024-01-15T00:00:00Z"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	assert.NoError(t, client.RevokeUserInvite(context.Background(), "42"))
+
+	invite, err := client.ResendUserInvite(context.Background(), "43")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-15T00:00:00Z", invite.ExpiresAt)
+}
@@ -1,9 +1,11 @@
 package retoolsdk
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"net/url"
 )
 
@@ -22,9 +24,9 @@ type User struct {
 }
 
 // GetUser returns the user. The API token must have the "Users > Read" scope.
-func (c *Client) GetUser(id string) (*User, error) {
+func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
 	baseURL := fmt.Sprintf("%s/users/%s", c.BaseURL, id)
-	return doSingleRequest[User](c, "GET", baseURL, nil)
+	return doSingleRequest[User](ctx, c, "GET", baseURL, nil)
 }
 
 // ListUserOpts is a struct that contains optional query parameters for ListUsers.
@@ -34,29 +36,44 @@ type ListUserOpts struct {
 	LastName  string
 }
 
-// ListUsers returns a list of users. The API token must have the "Users > Read" scope.
-func (c *Client) ListUsers(opts *ListUserOpts) ([]User, error) {
-	baseURL := fmt.Sprintf("%s/users", c.BaseURL)
-
+// values returns the query parameters for the options.
+func (o *ListUserOpts) values() url.Values {
 	query := make(url.Values)
 
-	if opts != nil {
-		if opts.Email != "" {
-			query.Add("email", opts.Email)
-		}
-		if opts.FirstName != "" {
-			query.Add("first_name", opts.FirstName)
-		}
-		if opts.LastName != "" {
-			query.Add("last_name", opts.LastName)
-		}
+	if o == nil {
+		return query
+	}
 
-		if len(query) > 0 {
-			baseURL = fmt.Sprintf("%s?%s", baseURL, query.Encode())
-		}
+	if o.Email != "" {
+		query.Add("email", o.Email)
+	}
+	if o.FirstName != "" {
+		query.Add("first_name", o.FirstName)
+	}
+	if o.LastName != "" {
+		query.Add("last_name", o.LastName)
 	}
 
-	return doPaginatedRequest[User](c, "GET", baseURL, nil, query)
+	return query
+}
+
+// ListUsers returns a list of users. The API token must have the "Users > Read" scope.
+func (c *Client) ListUsers(ctx context.Context, opts *ListUserOpts) ([]User, error) {
+	baseURL := fmt.Sprintf("%s/users", c.BaseURL)
+	return doPaginatedRequest[User](ctx, c, "GET", baseURL, nil, opts.values())
+}
+
+// AllUsers returns an iterator over all users, fetching pages lazily as the iteration progresses.
+// The API token must have the "Users > Read" scope.
+func (c *Client) AllUsers(ctx context.Context, opts *ListUserOpts) iter.Seq2[User, error] {
+	return paginateItems(c.UserPages(ctx, opts, ""))
+}
+
+// UserPages returns an iterator over the pages of users, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Users > Read" scope.
+func (c *Client) UserPages(ctx context.Context, opts *ListUserOpts, next string) iter.Seq2[*Page[User], error] {
+	baseURL := fmt.Sprintf("%s/users", c.BaseURL)
+	return paginatePages[User](ctx, c, "GET", baseURL, nil, opts.values(), next)
 }
 
 // CreateUserOpts is a struct that contains optional parameters for CreateUser.
@@ -74,22 +91,26 @@ const (
 
 // Validate ensures that the options provided in User have valid values.
 func (u *User) Validate() error {
+	return validateUserType(u.UserType)
+}
+
+// validateUserType checks that the user type is empty or one of the allowed user types.
+func validateUserType(userType string) error {
 	validUserTypes := map[string]struct{}{
 		UserTypeDefault: {},
 		UserTypeEmbed:   {},
 		UserTypeMobile:  {},
 	}
 
-	// Validate UserType
-	if _, ok := validUserTypes[u.UserType]; !ok && u.UserType != "" {
-		return fmt.Errorf("invalid value for UserType: %s", u.UserType)
+	if _, ok := validUserTypes[userType]; !ok && userType != "" {
+		return fmt.Errorf("invalid value for UserType: %s", userType)
 	}
 
 	return nil
 }
 
 // CreateUser creates a user and returns the created user. The API token must have the "Users > Write" scope.
-func (c *Client) CreateUser(email, firstName, lastName string, opts *CreateUserOpts) (*User, error) {
+func (c *Client) CreateUser(ctx context.Context, email, firstName, lastName string, opts *CreateUserOpts) (*User, error) {
 	newUser := &User{
 		Email:     email,
 		FirstName: firstName,
@@ -113,11 +134,11 @@ func (c *Client) CreateUser(email, firstName, lastName string, opts *CreateUserO
 	}
 
 	baseURL := fmt.Sprintf("%s/users", c.BaseURL)
-	return doSingleRequest[User](c, "POST", baseURL, newUser)
+	return doSingleRequest[User](ctx, c, "POST", baseURL, newUser)
 }
 
 // UpdateUser updates and returns the updated user. The API token must have the "Users > Write" scope.
-func (c *Client) UpdateUser(id string, operations []UpdateOperations) (*User, error) {
+func (c *Client) UpdateUser(ctx context.Context, id string, operations []UpdateOperations) (*User, error) {
 	if len(operations) == 0 {
 		return nil, errors.New("no operations provided")
 	}
@@ -140,12 +161,12 @@ func (c *Client) UpdateUser(id string, operations []UpdateOperations) (*User, er
 	}
 
 	baseURL := fmt.Sprintf("%s/users/%s", c.BaseURL, id)
-	return doSingleRequest[User](c, "PATCH", baseURL, requestBodyJSON)
+	return doSingleRequest[User](ctx, c, "PATCH", baseURL, requestBodyJSON)
 }
 
 // DeleteUser disables a user from the organization. The API token must have the "Users > Write" scope.
-func (c *Client) DeleteUser(id string) error {
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
 	baseURL := fmt.Sprintf("%s/users/%s", c.BaseURL, id)
-	_, err := doSingleRequest[any](c, "DELETE", baseURL, nil)
+	_, err := doSingleRequest[any](ctx, c, "DELETE", baseURL, nil)
 	return err
 }
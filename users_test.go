@@ -2,10 +2,12 @@ package retoolsdk_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	retool "github.com/thoughtgears/retoolsdk"
@@ -44,7 +46,7 @@ func TestGetUser_Success(t *testing.T) {
 		},
 	}
 
-	user, err := client.GetUser("user_123")
+	user, err := client.GetUser(context.Background(), "user_123")
 	assert.NoError(t, err)
 	assert.NotNil(t, user)
 	assert.Equal(t, "user_123", user.ID)
@@ -70,7 +72,7 @@ func TestGetUser_Failure(t *testing.T) {
 		},
 	}
 
-	user, err := client.GetUser("user_123")
+	user, err := client.GetUser(context.Background(), "user_123")
 	assert.Error(t, err)
 	assert.Nil(t, user)
 }
@@ -107,7 +109,7 @@ func TestListUser_Success(t *testing.T) {
 		},
 	}
 
-	users, err := client.ListUsers(nil)
+	users, err := client.ListUsers(context.Background(), nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, users)
 	assert.Len(t, users, 1)
@@ -159,7 +161,7 @@ func TestListUsers_Pagination(t *testing.T) {
 		},
 	}
 
-	users, err := client.ListUsers(nil)
+	users, err := client.ListUsers(context.Background(), nil)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, users)
@@ -187,7 +189,7 @@ func TestListUsers_EmptyResponse(t *testing.T) {
 		},
 	}
 
-	users, err := client.ListUsers(nil)
+	users, err := client.ListUsers(context.Background(), nil)
 
 	assert.NoError(t, err)
 	assert.Nil(t, users)
@@ -225,7 +227,7 @@ func TestCreateUser_Success(t *testing.T) {
 		Type:   retool.UserTypeDefault,
 	}
 
-	user, err := client.CreateUser("jane.doe@example.com", "Jane", "Doe", opts)
+	user, err := client.CreateUser(context.Background(), "jane.doe@example.com", "Jane", "Doe", opts)
 	assert.NoError(t, err)
 	assert.NotNil(t, user)
 	assert.Equal(t, "user_123", user.ID)
@@ -247,7 +249,7 @@ func TestCreateUser_ValidationFailure(t *testing.T) {
 		HTTPClient: &http.Client{},
 	}
 
-	_, err := client.CreateUser("", "", "", opts)
+	_, err := client.CreateUser(context.Background(), "", "", "", opts)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid value for UserType: wrong_type")
 }
@@ -276,7 +278,7 @@ func TestCreateUser_Failure(t *testing.T) {
 		Type:   retool.UserTypeDefault,
 	}
 
-	user, err := client.CreateUser("jane.doe@example.com", "Jane", "Doe", opts)
+	user, err := client.CreateUser(context.Background(), "jane.doe@example.com", "Jane", "Doe", opts)
 	assert.Error(t, err)
 	assert.Nil(t, user)
 	assert.Equal(t, "Internal server error", err.Error())
@@ -314,7 +316,7 @@ func TestUpdateUser_Success(t *testing.T) {
 		},
 	}
 
-	updatedUser, err := client.UpdateUser("123", operations)
+	updatedUser, err := client.UpdateUser(context.Background(), "123", operations)
 	assert.NoError(t, err)
 	assert.NotNil(t, updatedUser)
 	assert.Equal(t, "123", updatedUser.ID)
@@ -350,7 +352,7 @@ func TestUpdateUser_Failure(t *testing.T) {
 		},
 	}
 
-	updatedUser, err := client.UpdateUser("123", operations)
+	updatedUser, err := client.UpdateUser(context.Background(), "123", operations)
 	assert.Error(t, err)
 	assert.Nil(t, updatedUser)
 	assert.Equal(t, "validation failed for operation: value cannot be empty for replace operation", err.Error())
@@ -369,7 +371,7 @@ func TestDeleteUser_Success(t *testing.T) {
 		},
 	}
 
-	err := client.DeleteUser("user_123")
+	err := client.DeleteUser(context.Background(), "user_123")
 	assert.NoError(t, err)
 }
 
@@ -392,7 +394,30 @@ func TestDeleteUser_Failure(t *testing.T) {
 		},
 	}
 
-	err := client.DeleteUser("user_123")
+	err := client.DeleteUser(context.Background(), "user_123")
 	assert.Error(t, err)
 	assert.Equal(t, "No user 'user_123' found for organization", err.Error())
 }
+
+func TestListUsers_ContextCanceledBetweenPages(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		cancel()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "user_123"}], "next_token": "next_token", "has_more": true}`)
+	}))
+	defer server.Close()
+
+	client := &retool.Client{
+		BaseURL:    server.URL,
+		HTTPClient: &http.Client{},
+	}
+
+	users, err := client.ListUsers(ctx, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, users)
+	assert.Equal(t, int32(1), requests.Load())
+}
@@ -0,0 +1,200 @@
+package retoolsdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// ErrWorkflowRunFailed is returned by TriggerWorkflow when it waits for a run that does not succeed.
+var ErrWorkflowRunFailed = errors.New("workflow run failed")
+
+// Workflow is a struct that contains the information about a Retool workflow.
+type Workflow struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	FolderID    string `json:"folder_id"`
+	IsEnabled   bool   `json:"is_enabled"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// WorkflowRun is a struct that contains the information about a single run of a workflow.
+type WorkflowRun struct {
+	ID          string          `json:"id"`
+	WorkflowID  string          `json:"workflow_id"`
+	Status      string          `json:"status"`
+	TriggerType string          `json:"trigger_type"`
+	Output      json.RawMessage `json:"output,omitempty"`
+	Error       string          `json:"error,omitempty"`
+	CreatedAt   string          `json:"created_at"`
+	CompletedAt string          `json:"completed_at,omitempty"`
+}
+
+// Workflow run statuses.
+const (
+	WorkflowRunPending    = "pending"
+	WorkflowRunInProgress = "in_progress"
+	WorkflowRunSuccess    = "success"
+	WorkflowRunFailure    = "failure"
+	WorkflowRunCancelled  = "cancelled"
+)
+
+// Done reports whether the run has finished, successfully or not. Any status other than pending or in progress is
+// treated as finished, so that waiting stops on statuses added to the API later.
+func (r *WorkflowRun) Done() bool {
+	return r.Status != WorkflowRunPending && r.Status != WorkflowRunInProgress
+}
+
+// GetWorkflow returns the workflow with the given ID. The API token must have the "Workflows > Read" scope.
+func (c *Client) GetWorkflow(ctx context.Context, id string) (*Workflow, error) {
+	baseURL := fmt.Sprintf("%s/workflows/%s", c.BaseURL, id)
+	return doSingleRequest[Workflow](ctx, c, "GET", baseURL, nil)
+}
+
+// ListWorkflows returns a list of workflows. The API token must have the "Workflows > Read" scope.
+func (c *Client) ListWorkflows(ctx context.Context) ([]Workflow, error) {
+	baseURL := fmt.Sprintf("%s/workflows", c.BaseURL)
+	return doPaginatedRequest[Workflow](ctx, c, "GET", baseURL, nil, url.Values{})
+}
+
+// AllWorkflows returns an iterator over all workflows, fetching pages lazily as the iteration progresses.
+// The API token must have the "Workflows > Read" scope.
+func (c *Client) AllWorkflows(ctx context.Context) iter.Seq2[Workflow, error] {
+	return paginateItems(c.WorkflowPages(ctx, ""))
+}
+
+// WorkflowPages returns an iterator over the pages of workflows, starting at the page identified by next
+// (or the first page when empty). The API token must have the "Workflows > Read" scope.
+func (c *Client) WorkflowPages(ctx context.Context, next string) iter.Seq2[*Page[Workflow], error] {
+	baseURL := fmt.Sprintf("%s/workflows", c.BaseURL)
+	return paginatePages[Workflow](ctx, c, "GET", baseURL, nil, url.Values{}, next)
+}
+
+// TriggerWorkflowOpts is a struct that contains optional parameters for TriggerWorkflow.
+type TriggerWorkflowOpts struct {
+	// Wait polls the run until it finishes and returns the finished run.
+	Wait bool
+	// PollInterval is the time between polls when waiting. It defaults to 2 seconds.
+	PollInterval time.Duration
+}
+
+// TriggerWorkflow starts a run of the workflow with the payload, which is encoded as the JSON body of the trigger,
+// and returns the run. With opts.Wait it polls the run until it finishes or ctx is done; when the finished run did
+// not succeed the run is returned together with an error wrapping ErrWorkflowRunFailed.
+// The API token must have the "Workflows > Write" scope.
+func (c *Client) TriggerWorkflow(ctx context.Context, id string, payload any, opts *TriggerWorkflowOpts) (*WorkflowRun, error) {
+	var requestBody []byte
+	if payload != nil {
+		var err error
+		requestBody, err = json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling payload: %w", err)
+		}
+	}
+
+	baseURL := fmt.Sprintf("%s/workflows/%s/trigger", c.BaseURL, id)
+	run, err := doSingleRequest[WorkflowRun](ctx, c, "POST", baseURL, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, fmt.Errorf("triggering workflow %s: empty response", id)
+	}
+
+	if opts == nil || !opts.Wait {
+		return run, nil
+	}
+
+	return c.waitForWorkflowRun(ctx, id, run, opts.PollInterval)
+}
+
+// waitForWorkflowRun polls the run of the workflow every interval until it finishes.
+func (c *Client) waitForWorkflowRun(ctx context.Context, workflowID string, run *WorkflowRun, interval time.Duration) (*WorkflowRun, error) {
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+
+	for !run.Done() {
+		if err := sleepContext(ctx, interval); err != nil {
+			return run, fmt.Errorf("waiting for workflow run %s: %w", run.ID, err)
+		}
+
+		next, err := c.GetWorkflowRun(ctx, workflowID, run.ID)
+		if err != nil {
+			return run, fmt.Errorf("waiting for workflow run %s: %w", run.ID, err)
+		}
+		if next == nil {
+			return run, fmt.Errorf("waiting for workflow run %s: empty response", run.ID)
+		}
+		run = next
+	}
+
+	if run.Status != WorkflowRunSuccess {
+		if run.Error != "" {
+			return run, fmt.Errorf("%w: run %s finished with status %s: %s", ErrWorkflowRunFailed, run.ID, run.Status, run.Error)
+		}
+		return run, fmt.Errorf("%w: run %s finished with status %s", ErrWorkflowRunFailed, run.ID, run.Status)
+	}
+
+	return run, nil
+}
+
+// ListWorkflowRunsOpts is a struct that contains optional query parameters for ListWorkflowRuns.
+type ListWorkflowRunsOpts struct {
+	// Status only returns the runs with the given status.
+	Status string
+}
+
+// values returns the query parameters for the options.
+func (o *ListWorkflowRunsOpts) values() url.Values {
+	query := make(url.Values)
+
+	if o == nil {
+		return query
+	}
+
+	if o.Status != "" {
+		query.Add("status", o.Status)
+	}
+
+	return query
+}
+
+// ListWorkflowRuns returns the run history of the workflow, most recent first.
+// The API token must have the "Workflows > Read" scope.
+func (c *Client) ListWorkflowRuns(ctx context.Context, workflowID string, opts *ListWorkflowRunsOpts) ([]WorkflowRun, error) {
+	baseURL := fmt.Sprintf("%s/workflows/%s/runs", c.BaseURL, workflowID)
+	return doPaginatedRequest[WorkflowRun](ctx, c, "GET", baseURL, nil, opts.values())
+}
+
+// AllWorkflowRuns returns an iterator over the run history of the workflow, fetching pages lazily as the iteration
+// progresses. The API token must have the "Workflows > Read" scope.
+func (c *Client) AllWorkflowRuns(ctx context.Context, workflowID string, opts *ListWorkflowRunsOpts) iter.Seq2[WorkflowRun, error] {
+	return paginateItems(c.WorkflowRunPages(ctx, workflowID, opts, ""))
+}
+
+// WorkflowRunPages returns an iterator over the pages of runs of the workflow, starting at the page identified by
+// next (or the first page when empty). The API token must have the "Workflows > Read" scope.
+func (c *Client) WorkflowRunPages(ctx context.Context, workflowID string, opts *ListWorkflowRunsOpts, next string) iter.Seq2[*Page[WorkflowRun], error] {
+	baseURL := fmt.Sprintf("%s/workflows/%s/runs", c.BaseURL, workflowID)
+	return paginatePages[WorkflowRun](ctx, c, "GET", baseURL, nil, opts.values(), next)
+}
+
+// GetWorkflowRun returns a run of the workflow. The API token must have the "Workflows > Read" scope.
+func (c *Client) GetWorkflowRun(ctx context.Context, workflowID, runID string) (*WorkflowRun, error) {
+	baseURL := fmt.Sprintf("%s/workflows/%s/runs/%s", c.BaseURL, workflowID, runID)
+	return doSingleRequest[WorkflowRun](ctx, c, "GET", baseURL, nil)
+}
+
+// CancelWorkflowRun cancels a pending or in progress run of the workflow and returns the cancelled run.
+// The API token must have the "Workflows > Write" scope.
+func (c *Client) CancelWorkflowRun(ctx context.Context, workflowID, runID string) (*WorkflowRun, error) {
+	baseURL := fmt.Sprintf("%s/workflows/%s/runs/%s/cancel", c.BaseURL, workflowID, runID)
+	return doSingleRequest[WorkflowRun](ctx, c, "POST", baseURL, nil)
+}
@@ -0,0 +1,220 @@
+package retoolsdk_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	retool "github.com/thoughtgears/retoolsdk"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWorkflow_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/workflows/wf_123", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "wf_123", "name": "Nightly sync", "is_enabled": true}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	workflow, err := client.GetWorkflow(context.Background(), "wf_123")
+	assert.NoError(t, err)
+	assert.Equal(t, &retool.Workflow{ID: "wf_123", Name: "Nightly sync", IsEnabled: true}, workflow)
+}
+
+func TestListWorkflows_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/workflows", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "wf_1"}, {"id": "wf_2"}], "total_count": 2, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	workflows, err := client.ListWorkflows(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, workflows, 2)
+}
+
+func TestTriggerWorkflow_NoWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/workflows/wf_123/trigger", r.URL.Path)
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"environment": "staging"}`, string(body))
+
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "run_1", "workflow_id": "wf_123", "status": "pending"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	run, err := client.TriggerWorkflow(context.Background(), "wf_123", map[string]string{"environment": "staging"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "run_1", run.ID)
+	assert.False(t, run.Done())
+}
+
+func TestTriggerWorkflow_Wait(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workflows/wf_123/trigger":
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "run_1", "status": "pending"}}`)
+		case "/api/v2/workflows/wf_123/runs/run_1":
+			if polls.Add(1) < 3 {
+				fmt.Fprintln(w, `{"success": true, "data": {"id": "run_1", "status": "in_progress"}}`)
+				return
+			}
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "run_1", "status": "success", "output": {"rows": 42}}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	run, err := client.TriggerWorkflow(context.Background(), "wf_123", nil, &retool.TriggerWorkflowOpts{Wait: true, PollInterval: time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, retool.WorkflowRunSuccess, run.Status)
+	assert.JSONEq(t, `{"rows": 42}`, string(run.Output))
+	assert.Equal(t, int32(3), polls.Load())
+}
+
+func TestTriggerWorkflow_WaitFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "run_1", "status": "pending"}}`)
+			return
+		}
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "run_1", "status": "failure", "error": "query timed out"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	run, err := client.TriggerWorkflow(context.Background(), "wf_123", nil, &retool.TriggerWorkflowOpts{Wait: true, PollInterval: time.Millisecond})
+	assert.ErrorIs(t, err, retool.ErrWorkflowRunFailed)
+	assert.EqualError(t, err, "workflow run failed: run run_1 finished with status failure: query timed out")
+	assert.Equal(t, retool.WorkflowRunFailure, run.Status)
+}
+
+func TestTriggerWorkflow_WaitUnknownStatus(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "run_1", "status": "pending"}}`)
+			return
+		}
+		polls.Add(1)
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "run_1", "status": "timed_out"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	run, err := client.TriggerWorkflow(ctx, "wf_123", nil, &retool.TriggerWorkflowOpts{Wait: true, PollInterval: time.Millisecond})
+	assert.ErrorIs(t, err, retool.ErrWorkflowRunFailed)
+	assert.EqualError(t, err, "workflow run failed: run run_1 finished with status timed_out")
+	assert.Equal(t, "timed_out", run.Status)
+	assert.Equal(t, int32(1), polls.Load())
+}
+
+func TestTriggerWorkflow_WaitContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "run_1", "status": "in_progress"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	run, err := client.TriggerWorkflow(ctx, "wf_123", nil, &retool.TriggerWorkflowOpts{Wait: true, PollInterval: 10 * time.Millisecond})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, "run_1", run.ID)
+}
+
+func TestListWorkflowRuns_Status(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/workflows/wf_123/runs", r.URL.Path)
+		assert.Equal(t, "failure", r.URL.Query().Get("status"))
+		fmt.Fprintln(w, `{"success": true, "data": [{"id": "run_1", "status": "failure"}], "total_count": 1, "has_more": false}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	runs, err := client.ListWorkflowRuns(context.Background(), "wf_123", &retool.ListWorkflowRunsOpts{Status: retool.WorkflowRunFailure})
+	assert.NoError(t, err)
+	assert.Equal(t, []retool.WorkflowRun{{ID: "run_1", Status: "failure"}}, runs)
+}
+
+func TestCancelWorkflowRun_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/api/v2/workflows/wf_123/runs/run_1/cancel", r.URL.Path)
+		fmt.Fprintln(w, `{"success": true, "data": {"id": "run_1", "status": "cancelled"}}`)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	run, err := client.CancelWorkflowRun(context.Background(), "wf_123", "run_1")
+	assert.NoError(t, err)
+	assert.True(t, run.Done())
+}
+
+func TestTriggerWorkflow_NoContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	run, err := client.TriggerWorkflow(context.Background(), "wf_123", nil, &retool.TriggerWorkflowOpts{Wait: true, PollInterval: time.Millisecond})
+	assert.EqualError(t, err, "triggering workflow wf_123: empty response")
+	assert.Nil(t, run)
+}
+
+func TestTriggerWorkflow_WaitNoContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprintln(w, `{"success": true, "data": {"id": "run_1", "status": "pending"}}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := retool.NewClient("test-api-key", server.URL)
+	assert.NoError(t, err)
+
+	run, err := client.TriggerWorkflow(context.Background(), "wf_123", nil, &retool.TriggerWorkflowOpts{Wait: true, PollInterval: time.Millisecond})
+	assert.EqualError(t, err, "waiting for workflow run run_1: empty response")
+	assert.Equal(t, "run_1", run.ID)
+}